@@ -0,0 +1,400 @@
+// Package algo runs client-side execution algorithms on top of ws.Trade, the orders channel and the tickers channel.
+//
+// Every algorithm works a ParentOrder through child orders, reports its Progress after every change
+// and cancels its live child orders when its context is done.
+package algo
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/liuhengloveyou/okx-go"
+	"github.com/liuhengloveyou/okx-go/api/ws"
+	"github.com/liuhengloveyou/okx-go/events/private"
+	"github.com/liuhengloveyou/okx-go/events/public"
+	"github.com/liuhengloveyou/okx-go/models/trade"
+	requests "github.com/liuhengloveyou/okx-go/requests/rest/trade"
+	wsPrivate "github.com/liuhengloveyou/okx-go/requests/ws/private"
+	wsPublic "github.com/liuhengloveyou/okx-go/requests/ws/public"
+)
+
+// cancelSourcePostOnly is the cancelSource of a post-only order canceled because it would have taken liquidity
+const cancelSourcePostOnly = "31"
+
+var (
+	// ErrNoQuote is returned when no best bid or ask has been pushed for the instrument within the quote timeout
+	ErrNoQuote = errors.New("okx: algo got no quote for the instrument")
+	// ErrNotFilled is returned when an algorithm ran its course without filling the whole parent order
+	ErrNotFilled = errors.New("okx: algo ended before the parent order was filled")
+	// ErrNoAck is returned when a child order wasn't pushed on the orders channel within the ack timeout,
+	// which is what happens when OKX rejects it
+	ErrNoAck = errors.New("okx: algo child order not acknowledged")
+)
+
+type (
+	// Executor runs the algorithms over a websocket client, which must be allowed to trade
+	Executor struct {
+		ws           *ws.ClientWs
+		clOrdID      okx.ClOrdIDGenerator
+		QuoteTimeout time.Duration
+		AckTimeout   time.Duration
+	}
+	// ParentOrder is the order worked by an algorithm
+	ParentOrder struct {
+		InstID   string
+		InstType okx.InstrumentType
+		TdMode   okx.TradeMode
+		Ccy      string
+		Side     okx.OrderSide
+		PosSide  okx.PositionSide
+		Sz       float64
+		// LimitPx is the worst price of the child orders, zero for no limit
+		LimitPx float64
+		// LotSz rounds down the size of the child orders when set
+		LotSz float64
+		Tag   string
+	}
+	// Progress is reported to the ProgressFunc after every change of the child orders
+	Progress struct {
+		InstID   string
+		Sz       float64
+		FilledSz float64
+		AvgPx    float64
+		Orders   int // child orders placed so far
+		Done     bool
+		Err      error
+	}
+	// ProgressFunc is called from the goroutine running the algorithm, so it must not block
+	ProgressFunc func(Progress)
+
+	run struct {
+		e      *Executor
+		parent ParentOrder
+		fn     ProgressFunc
+		wake   chan struct{}
+
+		mu       sync.Mutex
+		bid, ask float64
+		children map[string]*child
+		order    []string
+		hOrders  *ws.Handler
+		hTickers *ws.Handler
+	}
+	child struct {
+		ordID     string
+		px        float64
+		sz        float64
+		accFillSz float64
+		avgPx     float64
+		state     okx.OrderState
+		source    string
+		sent      time.Time
+	}
+)
+
+// NewExecutor returns a pointer to a fresh Executor, its child orders get ClOrdIDs starting with prefix
+func NewExecutor(c *ws.ClientWs, prefix string) (*Executor, error) {
+	gen, err := okx.NewClOrdIDGenerator(prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Executor{ws: c, clOrdID: gen, QuoteTimeout: 10 * time.Second, AckTimeout: 5 * time.Second}, nil
+}
+
+// start subscribes to the orders and the tickers of the instrument of the parent order
+func (e *Executor) start(parent ParentOrder, fn ProgressFunc) (*run, error) {
+	r := &run{e: e, parent: parent, fn: fn, wake: make(chan struct{}, 1), children: make(map[string]*child)}
+
+	var err error
+	r.hOrders, err = e.ws.Private.OnOrder(wsPrivate.Order{InstID: parent.InstID, InstType: parent.InstType}, r.onOrders)
+	if err != nil {
+		return nil, err
+	}
+	r.hTickers, err = e.ws.Public.OnTickers(wsPublic.Tickers{InstID: parent.InstID}, r.onTickers)
+	if err != nil {
+		_ = r.hOrders.Unsubscribe()
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func (r *run) onOrders(e *private.Order) {
+	r.mu.Lock()
+	for _, o := range e.Orders {
+		if c, ok := r.children[o.ClOrdID]; ok {
+			r.update(c, o)
+		}
+	}
+	r.mu.Unlock()
+	r.signal()
+}
+
+func (r *run) update(c *child, o *trade.Order) {
+	c.ordID = o.OrdID
+	c.state = o.State
+	c.source = o.CancelSource
+	c.accFillSz = float64(o.AccFillSz)
+	c.avgPx = float64(o.AvgPx)
+	if o.Px > 0 {
+		c.px = float64(o.Px)
+	}
+	if o.Sz > 0 {
+		c.sz = float64(o.Sz)
+	}
+}
+
+func (r *run) onTickers(e *public.Tickers) {
+	r.mu.Lock()
+	for _, t := range e.Tickers {
+		r.bid, r.ask = float64(t.BidPx), float64(t.AskPx)
+	}
+	r.mu.Unlock()
+	r.signal()
+}
+
+func (r *run) signal() {
+	select {
+	case r.wake <- struct{}{}:
+	default:
+	}
+}
+
+// wait returns on the next update, after d when it's positive, or with the error of ctx
+func (r *run) wait(ctx context.Context, d time.Duration) error {
+	var timeout <-chan time.Time
+	if d > 0 {
+		t := time.NewTimer(d)
+		defer t.Stop()
+		timeout = t.C
+	}
+	select {
+	case <-r.wake:
+	case <-timeout:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	return nil
+}
+
+// quote waits for the best bid and ask of the instrument
+func (r *run) quote(ctx context.Context) (bid, ask float64, err error) {
+	deadline := time.Now().Add(r.e.QuoteTimeout)
+	for {
+		r.mu.Lock()
+		bid, ask = r.bid, r.ask
+		r.mu.Unlock()
+		if bid > 0 && ask > 0 {
+			return
+		}
+		left := time.Until(deadline)
+		if left <= 0 {
+			return 0, 0, ErrNoQuote
+		}
+		if err = r.wait(ctx, left); err != nil {
+			return
+		}
+	}
+}
+
+// passive returns the best price on the side of the parent order, offset towards the other side and capped by LimitPx
+func (r *run) passive(bid, ask, offset float64) float64 {
+	if r.parent.Side == okx.OrderBuy {
+		return r.capped(bid + offset)
+	}
+
+	return r.capped(ask - offset)
+}
+
+// aggressive returns the best price on the other side of the parent order, capped by LimitPx
+func (r *run) aggressive(bid, ask float64) float64 {
+	if r.parent.Side == okx.OrderBuy {
+		return r.capped(ask)
+	}
+
+	return r.capped(bid)
+}
+
+func (r *run) capped(px float64) float64 {
+	if r.parent.LimitPx <= 0 {
+		return px
+	}
+	if r.parent.Side == okx.OrderBuy && px > r.parent.LimitPx || r.parent.Side == okx.OrderSell && px < r.parent.LimitPx {
+		return r.parent.LimitPx
+	}
+
+	return px
+}
+
+// place sends a child order and returns its ClOrdID
+func (r *run) place(ordType okx.OrderType, px, sz float64) (string, error) {
+	id := r.e.clOrdID()
+	r.mu.Lock()
+	r.children[id] = &child{px: px, sz: sz, sent: time.Now()}
+	r.order = append(r.order, id)
+	r.mu.Unlock()
+
+	req := requests.PlaceOrder{
+		InstID:  r.parent.InstID,
+		Ccy:     r.parent.Ccy,
+		ClOrdID: id,
+		Tag:     r.parent.Tag,
+		Sz:      sz,
+		Px:      px,
+		TdMode:  r.parent.TdMode,
+		Side:    r.parent.Side,
+		PosSide: r.parent.PosSide,
+		OrdType: ordType,
+	}
+	if err := r.e.ws.Trade.PlaceOrder(req); err != nil {
+		r.mu.Lock()
+		r.children[id].state = okx.OrderFailed
+		r.mu.Unlock()
+		return "", err
+	}
+	r.report(false, nil)
+
+	return id, nil
+}
+
+func (r *run) amend(id string, px float64) error {
+	r.mu.Lock()
+	r.children[id].px = px
+	r.mu.Unlock()
+
+	return r.e.ws.Trade.AmendOrder(requests.AmendOrder{InstID: r.parent.InstID, ClOrdID: id, NewPx: px})
+}
+
+func (r *run) cancel(id string) error {
+	return r.e.ws.Trade.CancelOrder(requests.CancelOrder{InstID: r.parent.InstID, ClOrdID: id})
+}
+
+// settle waits for the child order to end, it fails with ErrNoAck when OKX doesn't acknowledge it in time
+func (r *run) settle(ctx context.Context, id string) error {
+	for {
+		c := r.child(id)
+		if !c.isLive() {
+			return nil
+		}
+		if err := r.acked(c); err != nil {
+			return err
+		}
+		if err := r.wait(ctx, r.e.AckTimeout); err != nil {
+			return err
+		}
+	}
+}
+
+// acked returns ErrNoAck when the child order was sent longer than the ack timeout ago without being pushed
+func (r *run) acked(c child) error {
+	if c.state == "" && time.Since(c.sent) > r.e.AckTimeout {
+		return ErrNoAck
+	}
+
+	return nil
+}
+
+// child returns a copy of the state of a child order
+func (r *run) child(id string) child {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return *r.children[id]
+}
+
+// isLive reports whether the child order may still fill, including when its placement wasn't acknowledged yet
+func (c child) isLive() bool {
+	switch c.state {
+	case "", okx.OrderLive, okx.OrderPartiallyFilled:
+		return true
+	}
+
+	return false
+}
+
+// lot rounds sz down to the lot size of the parent order
+func (r *run) lot(sz float64) float64 {
+	if r.parent.LotSz <= 0 {
+		return sz
+	}
+
+	return math.Floor(sz/r.parent.LotSz+1e-9) * r.parent.LotSz
+}
+
+// done reports whether the parent order is filled
+func (r *run) done() bool {
+	sz, _ := r.filled()
+
+	return sz >= r.parent.Sz*(1-1e-9)
+}
+
+// filled returns the size filled by all the child orders and its average price
+func (r *run) filled() (sz, avgPx float64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var notional float64
+	for _, c := range r.children {
+		sz += c.accFillSz
+		notional += c.accFillSz * c.avgPx
+	}
+	if sz > 0 {
+		avgPx = notional / sz
+	}
+
+	return
+}
+
+// remaining returns the size of the parent order neither filled nor working in a live child order
+func (r *run) remaining() float64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	left := r.parent.Sz
+	for _, c := range r.children {
+		if c.isLive() {
+			left -= c.sz
+		} else {
+			left -= c.accFillSz
+		}
+	}
+	if left < 0 {
+		return 0
+	}
+
+	return left
+}
+
+func (r *run) report(done bool, err error) {
+	if r.fn == nil {
+		return
+	}
+	sz, avgPx := r.filled()
+	r.mu.Lock()
+	n := len(r.order)
+	r.mu.Unlock()
+	r.fn(Progress{InstID: r.parent.InstID, Sz: r.parent.Sz, FilledSz: sz, AvgPx: avgPx, Orders: n, Done: done, Err: err})
+}
+
+// finish cancels the live child orders, unsubscribes and reports the final progress
+func (r *run) finish(err error) error {
+	r.mu.Lock()
+	var live []string
+	for _, id := range r.order {
+		if r.children[id].isLive() {
+			live = append(live, id)
+		}
+	}
+	r.mu.Unlock()
+	for _, id := range live {
+		_ = r.cancel(id)
+	}
+	_ = r.hOrders.Unsubscribe()
+	_ = r.hTickers.Unsubscribe()
+	r.report(true, err)
+
+	return err
+}
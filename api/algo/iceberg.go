@@ -0,0 +1,58 @@
+package algo
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+
+	"github.com/liuhengloveyou/okx-go"
+)
+
+// Iceberg works the parent order through limit orders showing only part of it, one at a time, until it's filled.
+//
+// The size of every child order is displaySz randomized by up to variance (a ratio, 0.2 for ±20%) so that they're harder to spot.
+// They're placed at LimitPx, or at the best price of their side when it's zero.
+func (e *Executor) Iceberg(ctx context.Context, parent ParentOrder, displaySz, variance float64, fn ProgressFunc) error {
+	if displaySz <= 0 || variance < 0 || variance >= 1 {
+		return errors.New("okx: iceberg needs a positive display size and a variance in [0, 1)")
+	}
+	r, err := e.start(parent, fn)
+	if err != nil {
+		return err
+	}
+
+	var id string
+	for !r.done() {
+		if id == "" || !r.child(id).isLive() {
+			if id != "" {
+				r.report(false, nil)
+			}
+			sz := r.lot(displaySz * (1 + variance*(2*rand.Float64()-1)))
+			if left := r.lot(r.remaining()); sz > left || sz <= 0 {
+				sz = left
+			}
+			if sz <= 0 {
+				return r.finish(ErrNotFilled)
+			}
+			px := parent.LimitPx
+			if px <= 0 {
+				bid, ask, err := r.quote(ctx)
+				if err != nil {
+					return r.finish(err)
+				}
+				px = r.passive(bid, ask, 0)
+			}
+			if id, err = r.place(okx.OrderLimit, px, sz); err != nil {
+				return r.finish(err)
+			}
+		}
+		if err := r.acked(r.child(id)); err != nil {
+			return r.finish(err)
+		}
+		if err := r.wait(ctx, r.e.AckTimeout); err != nil {
+			return r.finish(err)
+		}
+	}
+
+	return r.finish(nil)
+}
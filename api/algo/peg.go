@@ -0,0 +1,63 @@
+package algo
+
+import (
+	"context"
+
+	"github.com/liuhengloveyou/okx-go"
+)
+
+// Peg rests a post-only order at the best price of its side, improved by offset towards the other side and capped by LimitPx,
+// and amends it with AmendOrder whenever that price moves, until the parent order is filled.
+//
+// The order isn't amended while it's the best price itself, so that it doesn't chase its own price.
+// It's placed again at the new price when OKX cancels it, such as when it would have taken liquidity.
+func (e *Executor) Peg(ctx context.Context, parent ParentOrder, offset float64, fn ProgressFunc) error {
+	r, err := e.start(parent, fn)
+	if err != nil {
+		return err
+	}
+
+	var id string
+	for !r.done() {
+		bid, ask, err := r.quote(ctx)
+		if err != nil {
+			return r.finish(err)
+		}
+		px := r.passive(bid, ask, offset)
+
+		if id == "" || !r.child(id).isLive() {
+			if id != "" {
+				r.report(false, nil)
+			}
+			sz := r.lot(r.remaining())
+			if sz <= 0 {
+				return r.finish(ErrNotFilled)
+			}
+			if id, err = r.place(okx.OrderPostOnly, px, sz); err != nil {
+				return r.finish(err)
+			}
+		} else if c := r.child(id); c.ordID != "" && c.px != px && r.outbid(c.px, bid, ask) {
+			if err := r.amend(id, px); err != nil {
+				return r.finish(err)
+			}
+		}
+
+		if err := r.acked(r.child(id)); err != nil {
+			return r.finish(err)
+		}
+		if err := r.wait(ctx, r.e.AckTimeout); err != nil {
+			return r.finish(err)
+		}
+	}
+
+	return r.finish(nil)
+}
+
+// outbid reports whether the best price of the side of the parent order is better than px
+func (r *run) outbid(px, bid, ask float64) bool {
+	if r.parent.Side == okx.OrderBuy {
+		return bid > px
+	}
+
+	return ask < px
+}
@@ -0,0 +1,50 @@
+package algo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/liuhengloveyou/okx-go"
+)
+
+// PostOnly places the parent order post-only at the best price of its side, capped by LimitPx, and waits for it to fill.
+//
+// Every time OKX cancels it because it would have taken liquidity, it's repriced at the new best price and placed again,
+// up to retries times. Any other cancellation ends the algorithm with an error.
+func (e *Executor) PostOnly(ctx context.Context, parent ParentOrder, retries int, fn ProgressFunc) error {
+	r, err := e.start(parent, fn)
+	if err != nil {
+		return err
+	}
+
+	for attempt := 0; ; attempt++ {
+		bid, ask, err := r.quote(ctx)
+		if err != nil {
+			return r.finish(err)
+		}
+		sz := r.lot(r.remaining())
+		if sz <= 0 {
+			return r.finish(nil)
+		}
+		id, err := r.place(okx.OrderPostOnly, r.passive(bid, ask, 0), sz)
+		if err != nil {
+			return r.finish(err)
+		}
+		if err := r.settle(ctx, id); err != nil {
+			return r.finish(err)
+		}
+
+		c := r.child(id)
+		switch {
+		case r.done():
+			return r.finish(nil)
+		case c.state == okx.OrderCancel && c.source == cancelSourcePostOnly:
+			r.report(false, nil)
+			if attempt >= retries {
+				return r.finish(fmt.Errorf("okx: post-only order repriced %d times without resting", retries))
+			}
+		default:
+			return r.finish(fmt.Errorf("okx: post-only order %s ended %s", c.ordID, c.state))
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package algo
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/liuhengloveyou/okx-go"
+)
+
+// TWAP works the parent order in slices of equal size, one every duration/slices.
+//
+// It's VWAP with a flat volume profile.
+func (e *Executor) TWAP(ctx context.Context, parent ParentOrder, slices int, duration time.Duration, fn ProgressFunc) error {
+	if slices <= 0 {
+		return errors.New("okx: twap needs at least one slice")
+	}
+	profile := make([]float64, slices)
+	for i := range profile {
+		profile[i] = 1
+	}
+
+	return e.VWAP(ctx, parent, profile, duration, fn)
+}
+
+// VWAP works the parent order in slices sized after the weights of the volume profile, one every duration/len(profile).
+//
+// Each slice is an IOC order at the best price of the other side, capped by LimitPx,
+// and whatever a slice doesn't fill is carried over to the next one.
+// It returns ErrNotFilled when the last slice left part of the parent order unfilled.
+func (e *Executor) VWAP(ctx context.Context, parent ParentOrder, profile []float64, duration time.Duration, fn ProgressFunc) error {
+	var total float64
+	for _, w := range profile {
+		if w < 0 {
+			return errors.New("okx: vwap profile has a negative weight")
+		}
+		total += w
+	}
+	if total <= 0 {
+		return errors.New("okx: vwap profile has no weight")
+	}
+	r, err := e.start(parent, fn)
+	if err != nil {
+		return err
+	}
+
+	interval := duration / time.Duration(len(profile))
+	begin := time.Now()
+	var cum float64
+	for i, w := range profile {
+		if err := r.waitUntil(ctx, begin.Add(time.Duration(i)*interval)); err != nil {
+			return r.finish(err)
+		}
+		cum += w
+		filled, _ := r.filled()
+		sz := r.lot(parent.Sz*cum/total - filled)
+		if left := r.remaining(); sz > left {
+			sz = r.lot(left)
+		}
+		if sz <= 0 {
+			continue
+		}
+		bid, ask, err := r.quote(ctx)
+		if err != nil {
+			return r.finish(err)
+		}
+		id, err := r.place(okx.OrderIOC, r.aggressive(bid, ask), sz)
+		if err != nil {
+			return r.finish(err)
+		}
+		if err := r.settle(ctx, id); err != nil {
+			return r.finish(err)
+		}
+		r.report(false, nil)
+	}
+	if !r.done() {
+		return r.finish(ErrNotFilled)
+	}
+
+	return r.finish(nil)
+}
+
+// waitUntil waits until t, or returns the error of ctx
+func (r *run) waitUntil(ctx context.Context, t time.Time) error {
+	d := time.Until(t)
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -49,6 +49,8 @@ func NewClient(ctx context.Context, apiKey, secretKey, passphrase string, destin
 	r := rest.NewClient(apiKey, secretKey, passphrase, restURL, destination)
 	c := ws.NewClient(ctx, apiKey, secretKey, passphrase, map[bool]okx.BaseURL{true: wsPriURL, false: wsPubURL})
 
+	c.SetClock(r.Clock())
+
 	return &Client{r, c, ctx}, nil
 }
 
@@ -87,5 +89,7 @@ func NewClientWithIP(ctx context.Context, apiKey, secretKey, passphrase string,
 	r := rest.NewClientWithIP(apiKey, secretKey, passphrase, restURL, destination, ip)
 	c := ws.NewClientWithIP(ctx, apiKey, secretKey, passphrase, map[bool]okx.BaseURL{true: wsPriURL, false: wsPubURL}, ip)
 
+	c.SetClock(r.Clock())
+
 	return &Client{r, c, ctx}, nil
 }
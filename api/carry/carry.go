@@ -0,0 +1,262 @@
+// Package carry scans the perpetual swaps for funding rate carry, pairing their funding rates with the basis
+// of the swap and of the nearest future against spot.
+package carry
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/liuhengloveyou/okx-go"
+	"github.com/liuhengloveyou/okx-go/api/rest"
+	"github.com/liuhengloveyou/okx-go/models/publicdata"
+	marketRequests "github.com/liuhengloveyou/okx-go/requests/rest/market"
+	requests "github.com/liuhengloveyou/okx-go/requests/rest/public"
+)
+
+const (
+	// Year is the period the rates and the basis are annualized over
+	Year = 365 * 24 * time.Hour
+
+	// defaultFundingInterval is the interval between two fundings of most swaps
+	defaultFundingInterval = 8 * time.Hour
+	// minExpiry keeps the futures expiring sooner out of the basis, their annualized basis being mostly noise
+	minExpiry = 24 * time.Hour
+	// retryDelay is how long Run waits after a failed scan
+	retryDelay = time.Minute
+)
+
+type (
+	// Opportunity is the carry of a perpetual swap, the prices are last prices from the tickers
+	Opportunity struct {
+		InstID          string
+		Uly             string
+		FundingRate     float64
+		FundingTime     time.Time
+		FundingInterval time.Duration
+		// AnnualizedFunding is the funding rate over a year, earned by the shorts when positive
+		AnnualizedFunding float64
+		SwapPx            float64
+		// SpotID is empty when the underlying has no spot market
+		SpotID string
+		SpotPx float64
+		// SpotBasis is the premium of the swap over spot
+		SpotBasis float64
+		// FuturesID is the nearest future expiring in more than a day, empty when there's none
+		FuturesID      string
+		FuturesPx      float64
+		FuturesExpTime time.Time
+		// FuturesBasis is the premium of the future over spot, or over the swap without spot
+		FuturesBasis           float64
+		AnnualizedFuturesBasis float64
+	}
+	// Func is called by Scanner.Run with the opportunities of every scan, or its error
+	Func func([]Opportunity, error)
+
+	// Scanner scans the carry opportunities through the public REST endpoints
+	Scanner struct {
+		rest *rest.ClientRest
+		// Delay is how long after a funding time Run scans again, for the new rates to be published
+		Delay time.Duration
+	}
+)
+
+// NewScanner returns a pointer to a fresh Scanner
+func NewScanner(c *rest.ClientRest) *Scanner {
+	return &Scanner{rest: c, Delay: 10 * time.Second}
+}
+
+// Annualize returns rate, paid every interval, over a year
+func Annualize(rate float64, interval time.Duration) float64 {
+	if interval <= 0 {
+		return 0
+	}
+
+	return rate * float64(Year) / float64(interval)
+}
+
+// Scan returns the carry opportunities of all the live perpetual swaps, ranked by absolute annualized funding rate
+func (s *Scanner) Scan() ([]Opportunity, error) {
+	rates, err := s.rest.PublicData.GetFundingRate(requests.GetFundingRate{InstID: "ANY"})
+	if err != nil {
+		return nil, err
+	}
+	if rates.Code != 0 {
+		return nil, fmt.Errorf("okx: get funding rate failed, code %d: %s", rates.Code, rates.Msg)
+	}
+	prices := make(map[string]float64)
+	for _, t := range []okx.InstrumentType{okx.SwapInstrument, okx.SpotInstrument, okx.FuturesInstrument} {
+		if err := s.tickers(t, prices); err != nil {
+			return nil, err
+		}
+	}
+	futures, err := s.futures()
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]Opportunity, 0, len(rates.FundingRates))
+	for _, r := range rates.FundingRates {
+		if r.InstType != okx.SwapInstrument {
+			continue
+		}
+		res = append(res, opportunity(r, prices, futures))
+	}
+	sort.SliceStable(res, func(i, j int) bool {
+		return math.Abs(res[i].AnnualizedFunding) > math.Abs(res[j].AnnualizedFunding)
+	})
+
+	return res, nil
+}
+
+// Run scans right away and then again Delay after every funding time, until ctx is done.
+// A failed scan is reported to fn and retried a minute later.
+func (s *Scanner) Run(ctx context.Context, fn Func) error {
+	for {
+		ops, err := s.Scan()
+		fn(ops, err)
+
+		wait := retryDelay
+		if err == nil {
+			wait = time.Until(nextFunding(ops)) + s.Delay
+			if wait < s.Delay {
+				wait = s.Delay
+			}
+		}
+		t := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return ctx.Err()
+		case <-t.C:
+		}
+	}
+}
+
+// History returns the funding rates of a swap since a time, most recent first, paging through GetFundingRateHistory
+func (s *Scanner) History(instID string, since time.Time) ([]*publicdata.FundingRateHistory, error) {
+	var (
+		res []*publicdata.FundingRateHistory
+		req = requests.GetFundingRateHistory{InstID: instID}
+	)
+	for {
+		h, err := s.rest.PublicData.GetFundingRateHistory(req)
+		if err != nil {
+			return res, err
+		}
+		if h.Code != 0 {
+			return res, fmt.Errorf("okx: get funding rate history failed, code %d: %s", h.Code, h.Msg)
+		}
+		if len(h.FundingRates) == 0 {
+			return res, nil
+		}
+		for _, r := range h.FundingRates {
+			if time.Time(r.FundingTime).Before(since) {
+				return res, nil
+			}
+			res = append(res, r)
+		}
+		req.After = time.Time(h.FundingRates[len(h.FundingRates)-1].FundingTime).UnixMilli()
+	}
+}
+
+// MeanRealizedRate returns the mean of the realized rates of a funding rate history, zero when it's empty
+func MeanRealizedRate(history []*publicdata.FundingRateHistory) float64 {
+	if len(history) == 0 {
+		return 0
+	}
+	var sum float64
+	for _, r := range history {
+		sum += float64(r.RealizedRate)
+	}
+
+	return sum / float64(len(history))
+}
+
+func (s *Scanner) tickers(t okx.InstrumentType, prices map[string]float64) error {
+	res, err := s.rest.Market.GetTickers(marketRequests.GetTickers{InstType: t})
+	if err != nil {
+		return err
+	}
+	if res.Code != 0 {
+		return fmt.Errorf("okx: get %s tickers failed, code %d: %s", t, res.Code, res.Msg)
+	}
+	for _, tk := range res.Tickers {
+		prices[tk.InstID] = float64(tk.Last)
+	}
+
+	return nil
+}
+
+// futures returns the nearest live future of every underlying expiring in more than minExpiry
+func (s *Scanner) futures() (map[string]*publicdata.Instrument, error) {
+	res, err := s.rest.PublicData.GetInstruments(requests.GetInstruments{InstType: okx.FuturesInstrument})
+	if err != nil {
+		return nil, err
+	}
+	if res.Code != 0 {
+		return nil, fmt.Errorf("okx: get futures instruments failed, code %d: %s", res.Code, res.Msg)
+	}
+	min := time.Now().Add(minExpiry)
+	nearest := make(map[string]*publicdata.Instrument)
+	for _, in := range res.Instruments {
+		exp := time.Time(in.ExpTime)
+		if in.State != okx.InstrumentLive || exp.Before(min) {
+			continue
+		}
+		if n, ok := nearest[in.Uly]; !ok || exp.Before(time.Time(n.ExpTime)) {
+			nearest[in.Uly] = in
+		}
+	}
+
+	return nearest, nil
+}
+
+func opportunity(r *publicdata.FundingRateRest, prices map[string]float64, futures map[string]*publicdata.Instrument) Opportunity {
+	interval := time.Time(r.NextFundingTime).Sub(time.Time(r.FundingTime))
+	if interval <= 0 {
+		interval = defaultFundingInterval
+	}
+	o := Opportunity{
+		InstID:            r.InstID,
+		Uly:               strings.TrimSuffix(r.InstID, "-SWAP"),
+		FundingRate:       float64(r.FundingRate),
+		FundingTime:       time.Time(r.FundingTime),
+		FundingInterval:   interval,
+		AnnualizedFunding: Annualize(float64(r.FundingRate), interval),
+		SwapPx:            prices[r.InstID],
+	}
+
+	ref := o.SwapPx
+	if px := prices[o.Uly]; px > 0 {
+		o.SpotID, o.SpotPx, ref = o.Uly, px, px
+		if o.SwapPx > 0 {
+			o.SpotBasis = o.SwapPx/px - 1
+		}
+	}
+	if f, ok := futures[o.Uly]; ok && prices[f.InstID] > 0 && ref > 0 {
+		o.FuturesID, o.FuturesPx, o.FuturesExpTime = f.InstID, prices[f.InstID], time.Time(f.ExpTime)
+		o.FuturesBasis = o.FuturesPx/ref - 1
+		o.AnnualizedFuturesBasis = o.FuturesBasis * float64(Year) / float64(time.Until(o.FuturesExpTime))
+	}
+
+	return o
+}
+
+// nextFunding returns the earliest funding time of ops still to come
+func nextFunding(ops []Opportunity) (next time.Time) {
+	now := time.Now()
+	for _, o := range ops {
+		if o.FundingTime.After(now) && (next.IsZero() || o.FundingTime.Before(next)) {
+			next = o.FundingTime
+		}
+	}
+	if next.IsZero() {
+		return now.Add(defaultFundingInterval)
+	}
+
+	return next
+}
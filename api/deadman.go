@@ -0,0 +1,143 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/liuhengloveyou/okx-go/api/rest"
+	requests "github.com/liuhengloveyou/okx-go/requests/rest/trade"
+)
+
+const (
+	// MinCancelAllAfter and MaxCancelAllAfter bound the countdown accepted by OKX cancel-all-after
+	MinCancelAllAfter = 10 * time.Second
+	MaxCancelAllAfter = 120 * time.Second
+)
+
+// ErrUnhealthy is returned by DeadManSwitch.Run when the health check failed, wrapping its error
+var ErrUnhealthy = errors.New("okx: dead man's switch health check failed")
+
+// CancelAllAfterFunc arms the cancel-all-after countdown of the account for timeout, a zero timeout disarms it.
+// It returns the time the pending orders will be canceled at, as confirmed by the server.
+type CancelAllAfterFunc func(timeout time.Duration) (time.Time, error)
+
+// DeadManSwitch keeps the cancel-all-after countdown of OKX armed while the process is alive and healthy.
+//
+// The countdown is refreshed every third of the timeout, so a single failed refresh doesn't let it fire.
+// When the refreshing stops, because the context is canceled, the health check fails or the process hangs,
+// the countdown runs out and OKX cancels all the pending orders of the account.
+type DeadManSwitch struct {
+	refresh   CancelAllAfterFunc
+	timeout   time.Duration
+	healthy   func() error
+	onRefresh func(triggerTime time.Time, err error)
+
+	mu          sync.Mutex
+	triggerTime time.Time
+	lastErr     error
+}
+
+// NewDeadManSwitch returns a pointer to a fresh DeadManSwitch
+func NewDeadManSwitch(refresh CancelAllAfterFunc, timeout time.Duration) *DeadManSwitch {
+	return &DeadManSwitch{refresh: refresh, timeout: timeout}
+}
+
+// RestCancelAllAfter returns a CancelAllAfterFunc arming the countdown with rest.Trade.CancelAllAfter.
+//
+// OKX only serves cancel-all-after on REST, the websocket api has no such operation.
+func RestCancelAllAfter(t *rest.Trade, tag string) CancelAllAfterFunc {
+	return func(timeout time.Duration) (time.Time, error) {
+		res, err := t.CancelAllAfter(requests.CancelAllAfter{TimeOut: int64(timeout / time.Second), Tag: tag})
+		if err != nil {
+			return time.Time{}, err
+		}
+		if res.Code != 0 || len(res.CancelAllAfters) == 0 {
+			return time.Time{}, fmt.Errorf("okx: cancel all after failed, code %d: %s", res.Code, res.Msg)
+		}
+
+		return time.Time(res.CancelAllAfters[0].TriggerTime), nil
+	}
+}
+
+// DeadManSwitch returns a DeadManSwitch refreshed through the REST client
+func (c *Client) DeadManSwitch(timeout time.Duration, tag string) *DeadManSwitch {
+	return NewDeadManSwitch(RestCancelAllAfter(c.Rest.Trade, tag), timeout)
+}
+
+// SetHealthCheck set a check called before every refresh, the switch stops refreshing as soon as it returns an error
+func (d *DeadManSwitch) SetHealthCheck(fn func() error) {
+	d.healthy = fn
+}
+
+// SetRefreshHandler set a hook called after every refresh with the trigger time confirmed by the server, or the error.
+//
+// The hook is called from the Run goroutine, so it must not block.
+func (d *DeadManSwitch) SetRefreshHandler(fn func(triggerTime time.Time, err error)) {
+	d.onRefresh = fn
+}
+
+// TriggerTime returns the last trigger time confirmed by the server and the error of the last refresh, if any
+func (d *DeadManSwitch) TriggerTime() (time.Time, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.triggerTime, d.lastErr
+}
+
+// Run arms the countdown and keeps refreshing it until ctx is done or the health check fails.
+//
+// Failed refreshes are reported and retried on the next tick, they don't stop the switch.
+// The countdown is left armed when Run returns so that the pending orders get canceled, call Disarm for a clean shutdown.
+// It returns ctx.Err() or ErrUnhealthy wrapping the error of the health check.
+func (d *DeadManSwitch) Run(ctx context.Context) error {
+	if d.timeout < MinCancelAllAfter || d.timeout > MaxCancelAllAfter {
+		return fmt.Errorf("okx: cancel all after timeout %s out of [%s, %s]", d.timeout, MinCancelAllAfter, MaxCancelAllAfter)
+	}
+	ticker := time.NewTicker(d.timeout / 3)
+	defer ticker.Stop()
+
+	for {
+		if d.healthy != nil {
+			if err := d.healthy(); err != nil {
+				return fmt.Errorf("%w: %v", ErrUnhealthy, err)
+			}
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		d.arm(d.timeout)
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// Disarm stops the countdown on the server, the pending orders stay live
+func (d *DeadManSwitch) Disarm() error {
+	_, err := d.refresh(0)
+	d.mu.Lock()
+	d.triggerTime = time.Time{}
+	d.lastErr = err
+	d.mu.Unlock()
+
+	return err
+}
+
+func (d *DeadManSwitch) arm(timeout time.Duration) {
+	t, err := d.refresh(timeout)
+	d.mu.Lock()
+	if err == nil {
+		d.triggerTime = t
+	}
+	d.lastErr = err
+	d.mu.Unlock()
+	if d.onRefresh != nil {
+		d.onRefresh(t, err)
+	}
+}
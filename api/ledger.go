@@ -0,0 +1,88 @@
+package api
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/liuhengloveyou/okx-go"
+	"github.com/liuhengloveyou/okx-go/api/ws"
+	"github.com/liuhengloveyou/okx-go/events/private"
+	"github.com/liuhengloveyou/okx-go/events/public"
+	"github.com/liuhengloveyou/okx-go/ledger"
+	accountRequests "github.com/liuhengloveyou/okx-go/requests/rest/account"
+	wsPrivate "github.com/liuhengloveyou/okx-go/requests/ws/private"
+	wsPublic "github.com/liuhengloveyou/okx-go/requests/ws/public"
+)
+
+// LedgerWatch feeds a ledger.Ledger with the fills of the orders channel and the mark prices of its instruments
+type LedgerWatch struct {
+	handlers []*ws.Handler
+}
+
+// WatchLedger subscribes to the orders channel of every instrument type and to the mark prices of instIDs,
+// and feeds them to l until LedgerWatch.Stop is called
+func (c *Client) WatchLedger(l *ledger.Ledger, instIDs ...string) (*LedgerWatch, error) {
+	w := &LedgerWatch{}
+	h, err := c.Ws.Private.OnOrder(wsPrivate.Order{InstType: okx.AnyInstrument}, func(e *private.Order) {
+		for _, o := range e.Orders {
+			l.AddOrder(o)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	w.handlers = append(w.handlers, h)
+
+	for _, instID := range instIDs {
+		h, err = c.Ws.Public.OnMarkPrice(wsPublic.MarkPrice{InstID: instID}, func(e *public.MarkPrice) {
+			for _, p := range e.Prices {
+				l.SetMarkPrice(p.InstID, float64(p.MarkPx))
+			}
+		})
+		if err != nil {
+			w.Stop()
+			return nil, err
+		}
+		w.handlers = append(w.handlers, h)
+	}
+
+	return w, nil
+}
+
+// Stop unsubscribes from the channels feeding the ledger
+func (w *LedgerWatch) Stop() {
+	for _, h := range w.handlers {
+		_ = h.Unsubscribe()
+	}
+	w.handlers = nil
+}
+
+// LoadFunding books into l the funding fee bills of rest.Account.GetBills matching req, paging through them from
+// the most recent one, or from the archive of the last 3 months when arch is set.
+// It returns how many bills were booked, the ones already booked are skipped.
+func (c *Client) LoadFunding(l *ledger.Ledger, req accountRequests.GetBills, arch bool) (int, error) {
+	req.Type = okx.BillFundingFeeType
+	booked := 0
+	for {
+		res, err := c.Rest.Account.GetBills(req, arch)
+		if err != nil {
+			return booked, err
+		}
+		if res.Code != 0 {
+			return booked, fmt.Errorf("okx: get bills failed, code %d: %s", res.Code, res.Msg)
+		}
+		if len(res.Bills) == 0 {
+			return booked, nil
+		}
+		for _, b := range res.Bills {
+			if l.AddBill(b) {
+				booked++
+			}
+		}
+		after, err := strconv.ParseInt(res.Bills[len(res.Bills)-1].BillID, 10, 64)
+		if err != nil {
+			return booked, err
+		}
+		req.After = after
+	}
+}
@@ -2,9 +2,7 @@ package rest
 
 import (
 	"encoding/json"
-	"github.com/liuhengloveyou/okx-go"
 	"net/http"
-	"strings"
 
 	requests "github.com/liuhengloveyou/okx-go/requests/rest/account"
 	responses "github.com/liuhengloveyou/okx-go/responses/account"
@@ -28,11 +26,7 @@ func NewAccount(c *ClientRest) *Account {
 // https://www.okx.com/docs-v5/en/#rest-api-account-get-balance
 func (c *Account) GetBalance(req requests.GetBalance) (response responses.GetBalance, err error) {
 	p := "/api/v5/account/balance"
-	m := okx.S2M(req)
-	if len(req.Ccy) > 0 {
-		m["ccy"] = strings.Join(req.Ccy, ",")
-	}
-	res, err := c.client.Do(http.MethodGet, p, true, m)
+	res, err := c.client.Do(http.MethodGet, p, true, req)
 	if err != nil {
 		return
 	}
@@ -49,14 +43,7 @@ func (c *Account) GetBalance(req requests.GetBalance) (response responses.GetBal
 // https://www.okx.com/docs-v5/en/#rest-api-account-get-positions
 func (c *Account) GetPositions(req requests.GetPositions) (response responses.GetPositions, err error) {
 	p := "/api/v5/account/positions"
-	m := okx.S2M(req)
-	if len(req.InstID) > 0 {
-		m["instId"] = strings.Join(req.InstID, ",")
-	}
-	if len(req.PosID) > 0 {
-		m["posId"] = strings.Join(req.PosID, ",")
-	}
-	res, err := c.client.Do(http.MethodGet, p, true, m)
+	res, err := c.client.Do(http.MethodGet, p, true, req)
 	if err != nil {
 		return
 	}
@@ -73,8 +60,7 @@ func (c *Account) GetPositions(req requests.GetPositions) (response responses.Ge
 // https://www.okx.com/docs-v5/en/#rest-api-account-get-account-and-position-risk
 func (c *Account) GetAccountAndPositionRisk(req requests.GetAccountAndPositionRisk) (response responses.GetAccountAndPositionRisk, err error) {
 	p := "/api/v5/account/account-position-risk"
-	m := okx.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, true, m)
+	res, err := c.client.Do(http.MethodGet, p, true, req)
 	if err != nil {
 		return
 	}
@@ -98,8 +84,7 @@ func (c *Account) GetBills(req requests.GetBills, arc bool) (response responses.
 	if arc {
 		p = "/api/v5/account/bills-archive"
 	}
-	m := okx.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, true, m)
+	res, err := c.client.Do(http.MethodGet, p, true, req)
 	if err != nil {
 		return
 	}
@@ -133,8 +118,7 @@ func (c *Account) GetConfig() (response responses.GetConfig, err error) {
 // https://www.okx.com/docs-v5/en/#rest-api-account-set-position-mode
 func (c *Account) SetPositionMode(req requests.SetPositionMode) (response responses.SetPositionMode, err error) {
 	p := "/api/v5/account/set-position-mode"
-	m := okx.S2M(req)
-	res, err := c.client.Do(http.MethodPost, p, true, m)
+	res, err := c.client.Do(http.MethodPost, p, true, req)
 	if err != nil {
 		return
 	}
@@ -158,8 +142,7 @@ func (c *Account) SetPositionMode(req requests.SetPositionMode) (response respon
 // https://www.okx.com/docs-v5/en/#rest-api-account-set-leverage
 func (c *Account) SetLeverage(req requests.SetLeverage) (response responses.Leverage, err error) {
 	p := "/api/v5/account/set-leverage"
-	m := okx.S2M(req)
-	res, err := c.client.Do(http.MethodPost, p, true, m)
+	res, err := c.client.Do(http.MethodPost, p, true, req)
 	if err != nil {
 		return
 	}
@@ -175,11 +158,7 @@ func (c *Account) SetLeverage(req requests.SetLeverage) (response responses.Leve
 // https://www.okx.com/docs-v5/en/#rest-api-account-get-maximum-buy-sell-amount-or-open-amount
 func (c *Account) GetMaxBuySellAmount(req requests.GetMaxBuySellAmount) (response responses.GetMaxBuySellAmount, err error) {
 	p := "/api/v5/account/max-size"
-	m := okx.S2M(req)
-	if len(req.InstID) > 0 {
-		m["instId"] = strings.Join(req.InstID, ",")
-	}
-	res, err := c.client.Do(http.MethodGet, p, true, m)
+	res, err := c.client.Do(http.MethodGet, p, true, req)
 	if err != nil {
 		return
 	}
@@ -195,8 +174,7 @@ func (c *Account) GetMaxBuySellAmount(req requests.GetMaxBuySellAmount) (respons
 // https://www.okx.com/docs-v5/en/#rest-api-account-get-maximum-available-tradable-amount
 func (c *Account) GetMaxAvailableTradeAmount(req requests.GetMaxAvailableTradeAmount) (response responses.GetMaxAvailableTradeAmount, err error) {
 	p := "/api/v5/account/max-avail-size"
-	m := okx.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, true, m)
+	res, err := c.client.Do(http.MethodGet, p, true, req)
 	if err != nil {
 		return
 	}
@@ -213,8 +191,7 @@ func (c *Account) GetMaxAvailableTradeAmount(req requests.GetMaxAvailableTradeAm
 // https://www.okx.com/docs-v5/en/#rest-api-account-increase-decrease-margin
 func (c *Account) IncreaseDecreaseMargin(req requests.IncreaseDecreaseMargin) (response responses.IncreaseDecreaseMargin, err error) {
 	p := "/api/v5/account/position/margin-balance"
-	m := okx.S2M(req)
-	res, err := c.client.Do(http.MethodPost, p, true, m)
+	res, err := c.client.Do(http.MethodPost, p, true, req)
 	if err != nil {
 		return
 	}
@@ -230,11 +207,7 @@ func (c *Account) IncreaseDecreaseMargin(req requests.IncreaseDecreaseMargin) (r
 // https://www.okx.com/docs-v5/en/#rest-api-account-get-leverage
 func (c *Account) GetLeverage(req requests.GetLeverage) (response responses.Leverage, err error) {
 	p := "/api/v5/account/leverage-info"
-	m := okx.S2M(req)
-	if len(req.InstID) > 0 {
-		m["instId"] = strings.Join(req.InstID, ",")
-	}
-	res, err := c.client.Do(http.MethodGet, p, true, m)
+	res, err := c.client.Do(http.MethodGet, p, true, req)
 	if err != nil {
 		return
 	}
@@ -250,8 +223,7 @@ func (c *Account) GetLeverage(req requests.GetLeverage) (response responses.Leve
 // https://www.okx.com/docs-v5/zh/#trading-account-rest-api-set-auto-loan
 func (c *Account) SetAutoLoan(req requests.SetAutoLoan) (response responses.SetAutoLoan, err error) {
 	p := "/api/v5/account/set-auto-loan"
-	m := okx.S2M(req)
-	res, err := c.client.Do(http.MethodPost, p, true, m)
+	res, err := c.client.Do(http.MethodPost, p, true, req)
 	if err != nil {
 		return
 	}
@@ -267,8 +239,7 @@ func (c *Account) SetAutoLoan(req requests.SetAutoLoan) (response responses.SetA
 // https://www.okx.com/docs-v5/en/#rest-api-account-get-the-maximum-loan-of-instrument
 func (c *Account) GetMaxLoan(req requests.GetMaxLoan) (response responses.GetMaxLoan, err error) {
 	p := "/api/v5/account/max-loan"
-	m := okx.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, true, m)
+	res, err := c.client.Do(http.MethodGet, p, true, req)
 	if err != nil {
 		return
 	}
@@ -284,8 +255,7 @@ func (c *Account) GetMaxLoan(req requests.GetMaxLoan) (response responses.GetMax
 // https://www.okx.com/docs-v5/en/#rest-api-account-get-fee-rates
 func (c *Account) GetFeeRates(req requests.GetFeeRates) (response responses.GetFeeRates, err error) {
 	p := "/api/v5/account/trade-fee"
-	m := okx.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, true, m)
+	res, err := c.client.Do(http.MethodGet, p, true, req)
 	if err != nil {
 		return
 	}
@@ -301,8 +271,7 @@ func (c *Account) GetFeeRates(req requests.GetFeeRates) (response responses.GetF
 // https://www.okx.com/docs-v5/en/#rest-api-account-get-interest-accrued
 func (c *Account) GetInterestAccrued(req requests.GetInterestAccrued) (response responses.GetInterestAccrued, err error) {
 	p := "/api/v5/account/interest-accrued"
-	m := okx.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, true, m)
+	res, err := c.client.Do(http.MethodGet, p, true, req)
 	if err != nil {
 		return
 	}
@@ -319,11 +288,7 @@ func (c *Account) GetInterestAccrued(req requests.GetInterestAccrued) (response
 // https://www.okx.com/docs-v5/en/#rest-api-account-get-interest-rate
 func (c *Account) GetInterestRates(req requests.GetBalance) (response responses.GetInterestRates, err error) {
 	p := "/api/v5/account/interest-rate"
-	m := okx.S2M(req)
-	if len(req.Ccy) > 0 {
-		m["ccy"] = strings.Join(req.Ccy, ",")
-	}
-	res, err := c.client.Do(http.MethodGet, p, true, m)
+	res, err := c.client.Do(http.MethodGet, p, true, req)
 	if err != nil {
 		return
 	}
@@ -340,8 +305,7 @@ func (c *Account) GetInterestRates(req requests.GetBalance) (response responses.
 // https://www.okx.com/docs-v5/en/#rest-api-account-set-greeks-m-bs
 func (c *Account) SetGreeks(req requests.SetGreeks) (response responses.SetGreeks, err error) {
 	p := "/api/v5/account/set-greeks"
-	m := okx.S2M(req)
-	res, err := c.client.Do(http.MethodPost, p, true, m)
+	res, err := c.client.Do(http.MethodPost, p, true, req)
 	if err != nil {
 		return
 	}
@@ -357,11 +321,7 @@ func (c *Account) SetGreeks(req requests.SetGreeks) (response responses.SetGreek
 // https://www.okx.com/docs-v5/en/#rest-api-account-get-maximum-withdrawals
 func (c *Account) GetMaxWithdrawals(req requests.GetBalance) (response responses.GetMaxWithdrawals, err error) {
 	p := "/api/v5/account/max-withdrawal"
-	m := okx.S2M(req)
-	if len(req.Ccy) > 0 {
-		m["ccy"] = strings.Join(req.Ccy, ",")
-	}
-	res, err := c.client.Do(http.MethodGet, p, true, m)
+	res, err := c.client.Do(http.MethodGet, p, true, req)
 	if err != nil {
 		return
 	}
@@ -377,8 +337,7 @@ func (c *Account) GetMaxWithdrawals(req requests.GetBalance) (response responses
 // https://www.okx.com/docs-v5/zh/#trading-account-rest-api-get-borrow-interest-and-limit
 func (c *Account) GetInterestLimits(req requests.GetInterestLimits) (response responses.GetInterestLimits, err error) {
 	p := "/api/v5/account/interest-limits"
-	m := okx.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, true, m)
+	res, err := c.client.Do(http.MethodGet, p, true, req)
 	if err != nil {
 		return
 	}
@@ -394,8 +353,314 @@ func (c *Account) GetInterestLimits(req requests.GetInterestLimits) (response re
 // https://www.okx.com/docs-v5/zh/#trading-account-rest-api-set-account-mode
 func (c *Account) SetAccountLevel(req requests.SetAccountLevel) (response responses.SetAccountLevel, err error) {
 	p := "/api/v5/account/set-account-level"
-	m := okx.S2M(req)
-	res, err := c.client.Do(http.MethodPost, p, true, m)
+	res, err := c.client.Do(http.MethodPost, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// SetIsolatedMode
+// Set the isolated margin trading settings of MARGIN or CONTRACTS.
+//
+// https://www.okx.com/docs-v5/en/#trading-account-rest-api-isolated-margin-trading-settings
+func (c *Account) SetIsolatedMode(req requests.SetIsolatedMode) (response responses.SetIsolatedMode, err error) {
+	p := "/api/v5/account/set-isolated-mode"
+	res, err := c.client.Do(http.MethodPost, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// PrecheckAccountLevel
+// Check whether the account can be switched to the given acctLv, use it before SetAccountLevel.
+// A non zero SCode tells which of the returned checks blocks the switch.
+//
+// https://www.okx.com/docs-v5/en/#trading-account-rest-api-preset-account-mode-switch
+func (c *Account) PrecheckAccountLevel(req requests.PrecheckAccountLevel) (response responses.PrecheckAccountLevel, err error) {
+	p := "/api/v5/account/set-account-switch-precheck"
+	res, err := c.client.Do(http.MethodPost, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// SetRiskOffsetType
+// Set the risk offset type of the portfolio margin account.
+//
+// https://www.okx.com/docs-v5/en/#trading-account-rest-api-set-risk-offset-type
+func (c *Account) SetRiskOffsetType(req requests.SetRiskOffsetType) (response responses.SetRiskOffsetType, err error) {
+	p := "/api/v5/account/set-riskOffset-type"
+	res, err := c.client.Do(http.MethodPost, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// SetAutoRepay
+// Set whether the borrowings of the spot mode are repaid automatically.
+//
+// https://www.okx.com/docs-v5/en/#trading-account-rest-api-set-auto-repay
+func (c *Account) SetAutoRepay(req requests.SetAutoRepay) (response responses.SetAutoRepay, err error) {
+	p := "/api/v5/account/set-auto-repay"
+	res, err := c.client.Do(http.MethodPost, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// ActivateOption
+// Activate options trading on the account.
+//
+// https://www.okx.com/docs-v5/en/#trading-account-rest-api-activate-option
+func (c *Account) ActivateOption() (response responses.ActivateOption, err error) {
+	p := "/api/v5/account/activate-option"
+	res, err := c.client.Do(http.MethodPost, p, true)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// GetMMPConfig
+// Retrieve the market maker protection config of the instrument families.
+//
+// https://www.okx.com/docs-v5/en/#trading-account-rest-api-get-mmp-config
+func (c *Account) GetMMPConfig(req requests.GetMMPConfig) (response responses.MMPConfig, err error) {
+	p := "/api/v5/account/mmp-config"
+	res, err := c.client.Do(http.MethodGet, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// SetMMPConfig
+// Set the market maker protection config of an instrument family.
+//
+// https://www.okx.com/docs-v5/en/#trading-account-rest-api-set-mmp
+func (c *Account) SetMMPConfig(req requests.SetMMPConfig) (response responses.MMPConfig, err error) {
+	p := "/api/v5/account/mmp-config"
+	res, err := c.client.Do(http.MethodPost, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// ResetMMP
+// Unfreeze an instrument family frozen by the market maker protection.
+//
+// https://www.okx.com/docs-v5/en/#trading-account-rest-api-reset-mmp-status
+func (c *Account) ResetMMP(req requests.ResetMMP) (response responses.ResetMMP, err error) {
+	p := "/api/v5/account/mmp-reset"
+	res, err := c.client.Do(http.MethodPost, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// QuickMarginBorrowRepay
+// Borrow or repay in the quick margin mode of an isolated MARGIN instrument.
+//
+// https://www.okx.com/docs-v5/en/#trading-account-rest-api-manual-borrow-and-repay-in-quick-margin-mode
+func (c *Account) QuickMarginBorrowRepay(req requests.QuickMarginBorrowRepay) (response responses.QuickMarginBorrowRepay, err error) {
+	p := "/api/v5/account/quick-margin-borrow-repay"
+	res, err := c.client.Do(http.MethodPost, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// GetQuickMarginBorrowRepayHistory
+// Retrieve the borrow and repay history in the quick margin mode of the last year.
+//
+// https://www.okx.com/docs-v5/en/#trading-account-rest-api-get-borrow-and-repay-history-in-quick-margin-mode
+func (c *Account) GetQuickMarginBorrowRepayHistory(req requests.GetQuickMarginBorrowRepayHistory) (response responses.GetQuickMarginBorrowRepayHistory, err error) {
+	p := "/api/v5/account/quick-margin-borrow-repay-history"
+	res, err := c.client.Do(http.MethodGet, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// PositionBuilder
+// Calculate the margin of simulated positions and assets, alone or on top of the real ones.
+//
+// https://www.okx.com/docs-v5/en/#trading-account-rest-api-position-builder-new
+func (c *Account) PositionBuilder(req requests.PositionBuilder) (response responses.PositionBuilder, err error) {
+	p := "/api/v5/account/position-builder"
+	res, err := c.client.Do(http.MethodPost, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// GetGreeks
+// Retrieve the greeks of the assets in the account.
+//
+// https://www.okx.com/docs-v5/en/#trading-account-rest-api-get-greeks
+func (c *Account) GetGreeks(req requests.GetGreeks) (response responses.GetGreeks, err error) {
+	p := "/api/v5/account/greeks"
+	res, err := c.client.Do(http.MethodGet, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// GetPositionsHistory
+// Retrieve the updated positions of the last 3 months, sorted by uTime.
+//
+// https://www.okx.com/docs-v5/en/#trading-account-rest-api-get-positions-history
+func (c *Account) GetPositionsHistory(req requests.GetPositionsHistory) (response responses.GetPositionsHistory, err error) {
+	p := "/api/v5/account/positions-history"
+	res, err := c.client.Do(http.MethodGet, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// RequestBillsArchive
+// Request the generation of the bills archive of a quarter, retrieve it with GetBillsArchive once ready.
+//
+// https://www.okx.com/docs-v5/en/#trading-account-rest-api-apply-bills-details-since-2021
+func (c *Account) RequestBillsArchive(req requests.BillsArchive) (response responses.RequestBillsArchive, err error) {
+	p := "/api/v5/account/bills-history-archive"
+	res, err := c.client.Do(http.MethodPost, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// GetBillsArchive
+// Retrieve the download link of the bills archive of a quarter.
+//
+// https://www.okx.com/docs-v5/en/#trading-account-rest-api-get-bills-details-since-2021
+func (c *Account) GetBillsArchive(req requests.BillsArchive) (response responses.GetBillsArchive, err error) {
+	p := "/api/v5/account/bills-history-archive"
+	res, err := c.client.Do(http.MethodGet, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// PlaceFixedLoanBorrowingOrder
+// Borrow at a fixed rate for a fixed term.
+//
+// https://www.okx.com/docs-v5/en/#trading-account-rest-api-place-fixed-loan-borrowing-order
+func (c *Account) PlaceFixedLoanBorrowingOrder(req requests.PlaceFixedLoanBorrowingOrder) (response responses.FixedLoanBorrowingOrder, err error) {
+	p := "/api/v5/account/fixed-loan/borrowing-order"
+	res, err := c.client.Do(http.MethodPost, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// GetFixedLoanBorrowingOrders
+// Retrieve the fixed loan borrowing orders.
+//
+// https://www.okx.com/docs-v5/en/#trading-account-rest-api-get-fixed-loan-borrow-order-list
+func (c *Account) GetFixedLoanBorrowingOrders(req requests.GetFixedLoanBorrowingOrders) (response responses.FixedLoanBorrowingOrder, err error) {
+	p := "/api/v5/account/fixed-loan/borrowing-orders-list"
+	res, err := c.client.Do(http.MethodGet, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// RepayFixedLoanBorrowingOrder
+// Repay a fixed loan borrowing order before its term.
+//
+// https://www.okx.com/docs-v5/en/#trading-account-rest-api-repay-fixed-loan-borrowing-order
+func (c *Account) RepayFixedLoanBorrowingOrder(req requests.RepayFixedLoanBorrowingOrder) (response responses.FixedLoanBorrowingOrder, err error) {
+	p := "/api/v5/account/fixed-loan/repay-borrowing-order"
+	res, err := c.client.Do(http.MethodPost, p, true, req)
 	if err != nil {
 		return
 	}
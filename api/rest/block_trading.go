@@ -0,0 +1,311 @@
+package rest
+
+import (
+	"encoding/json"
+	"net/http"
+
+	requests "github.com/liuhengloveyou/okx-go/requests/rest/blocktrading"
+	responses "github.com/liuhengloveyou/okx-go/responses/block_trading"
+)
+
+// BlockTrading
+//
+// https://www.okx.com/docs-v5/en/#block-trading-rest-api
+type BlockTrading struct {
+	client *ClientRest
+}
+
+// NewBlockTrading returns a pointer to a fresh BlockTrading
+func NewBlockTrading(c *ClientRest) *BlockTrading {
+	return &BlockTrading{c}
+}
+
+// GetCounterparties
+// Retrieve the list of counterparties that the user is permitted to trade with.
+//
+// https://www.okx.com/docs-v5/en/#block-trading-rest-api-get-counterparties
+func (c *BlockTrading) GetCounterparties() (response responses.Counterparties, err error) {
+	p := "/api/v5/rfq/counterparties"
+	res, err := c.client.Do(http.MethodGet, p, true)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// CreateRFQ
+// Create a RFQ, sent to the counterparties with its legs.
+//
+// https://www.okx.com/docs-v5/en/#block-trading-rest-api-create-rfq
+func (c *BlockTrading) CreateRFQ(req requests.CreateRFQ) (response responses.RFQs, err error) {
+	p := "/api/v5/rfq/create-rfq"
+	res, err := c.client.Do(http.MethodPost, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// CancelRFQ
+// Cancel an existing active RFQ.
+//
+// https://www.okx.com/docs-v5/en/#block-trading-rest-api-cancel-rfq
+func (c *BlockTrading) CancelRFQ(req requests.CancelRFQ) (response responses.CancelRFQ, err error) {
+	p := "/api/v5/rfq/cancel-rfq"
+	res, err := c.client.Do(http.MethodPost, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// CancelBatchRFQs
+// Cancel one or multiple active RFQs in a single batch. Maximum 100 RFQ orders can be canceled per request.
+//
+// https://www.okx.com/docs-v5/en/#block-trading-rest-api-cancel-multiple-rfqs
+func (c *BlockTrading) CancelBatchRFQs(req requests.CancelBatchRFQs) (response responses.CancelRFQ, err error) {
+	p := "/api/v5/rfq/cancel-batch-rfqs"
+	res, err := c.client.Do(http.MethodPost, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// CancelAllRFQs
+// Cancel all active RFQs.
+//
+// https://www.okx.com/docs-v5/en/#block-trading-rest-api-cancel-all-rfqs
+func (c *BlockTrading) CancelAllRFQs() (response responses.CancelAll, err error) {
+	p := "/api/v5/rfq/cancel-all-rfqs"
+	res, err := c.client.Do(http.MethodPost, p, true)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// ExecuteQuote
+// Execute a quote, only the creator of the RFQ can execute it.
+// Legs only needs to be set to partially execute a RFQ allowing it.
+//
+// https://www.okx.com/docs-v5/en/#block-trading-rest-api-execute-quote
+func (c *BlockTrading) ExecuteQuote(req requests.ExecuteQuote) (response responses.Trades, err error) {
+	p := "/api/v5/rfq/execute-quote"
+	res, err := c.client.Do(http.MethodPost, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// CreateQuote
+// Allows the user to quote an RFQ that they are a counterparty to.
+//
+// https://www.okx.com/docs-v5/en/#block-trading-rest-api-create-quote
+func (c *BlockTrading) CreateQuote(req requests.CreateQuote) (response responses.Quotes, err error) {
+	p := "/api/v5/rfq/create-quote"
+	res, err := c.client.Do(http.MethodPost, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// CancelQuote
+// Cancel an existing active quote created in response to an RFQ.
+//
+// https://www.okx.com/docs-v5/en/#block-trading-rest-api-cancel-quote
+func (c *BlockTrading) CancelQuote(req requests.CancelQuote) (response responses.CancelQuote, err error) {
+	p := "/api/v5/rfq/cancel-quote"
+	res, err := c.client.Do(http.MethodPost, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// CancelBatchQuotes
+// Cancel one or multiple active quotes in a single batch. Maximum 100 quote orders can be canceled per request.
+//
+// https://www.okx.com/docs-v5/en/#block-trading-rest-api-cancel-multiple-quotes
+func (c *BlockTrading) CancelBatchQuotes(req requests.CancelBatchQuotes) (response responses.CancelQuote, err error) {
+	p := "/api/v5/rfq/cancel-batch-quotes"
+	res, err := c.client.Do(http.MethodPost, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// CancelAllQuotes
+// Cancel all active quotes.
+//
+// https://www.okx.com/docs-v5/en/#block-trading-rest-api-cancel-all-quotes
+func (c *BlockTrading) CancelAllQuotes() (response responses.CancelAll, err error) {
+	p := "/api/v5/rfq/cancel-all-quotes"
+	res, err := c.client.Do(http.MethodPost, p, true)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// GetRFQs
+// Retrieve the details of RFQs that the user is a counterparty to, either as the creator or the receiver.
+//
+// https://www.okx.com/docs-v5/en/#block-trading-rest-api-get-rfqs
+func (c *BlockTrading) GetRFQs(req requests.GetRFQs) (response responses.RFQs, err error) {
+	p := "/api/v5/rfq/rfqs"
+	res, err := c.client.Do(http.MethodGet, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// GetQuotes
+// Retrieve all quotes that the user is a counterparty to, either as the creator or the receiver.
+//
+// https://www.okx.com/docs-v5/en/#block-trading-rest-api-get-quotes
+func (c *BlockTrading) GetQuotes(req requests.GetQuotes) (response responses.Quotes, err error) {
+	p := "/api/v5/rfq/quotes"
+	res, err := c.client.Do(http.MethodGet, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// GetTrades
+// Retrieve the executed trades that the user is a counterparty to, either as the creator or the receiver.
+//
+// https://www.okx.com/docs-v5/en/#block-trading-rest-api-get-trades
+func (c *BlockTrading) GetTrades(req requests.GetTrades) (response responses.Trades, err error) {
+	p := "/api/v5/rfq/trades"
+	res, err := c.client.Do(http.MethodGet, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// GetPublicTrades
+// Retrieve the recent executed block trades.
+//
+// https://www.okx.com/docs-v5/en/#block-trading-rest-api-get-public-multi-leg-transactions-of-block-trades
+func (c *BlockTrading) GetPublicTrades(req requests.GetPublicTrades) (response responses.PublicTrades, err error) {
+	p := "/api/v5/rfq/public-trades"
+	res, err := c.client.Do(http.MethodGet, p, false, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// ResetMMP
+// Reset the MMP status to be inactive.
+//
+// https://www.okx.com/docs-v5/en/#block-trading-rest-api-reset-mmp-status
+func (c *BlockTrading) ResetMMP() (response responses.MMPReset, err error) {
+	p := "/api/v5/rfq/mmp-reset"
+	res, err := c.client.Do(http.MethodPost, p, true)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// SetMMPConfig
+// Set the MMP config of the quotes, setting the time interval to 0 disables it.
+//
+// https://www.okx.com/docs-v5/en/#block-trading-rest-api-set-mmp
+func (c *BlockTrading) SetMMPConfig(req requests.SetMMPConfig) (response responses.MMPConfig, err error) {
+	p := "/api/v5/rfq/mmp-config"
+	res, err := c.client.Do(http.MethodPost, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// GetMMPConfig
+// Retrieve the MMP config and status of the quotes.
+//
+// https://www.okx.com/docs-v5/en/#block-trading-rest-api-get-mmp-config
+func (c *BlockTrading) GetMMPConfig() (response responses.MMPConfig, err error) {
+	p := "/api/v5/rfq/mmp-config"
+	res, err := c.client.Do(http.MethodGet, p, true)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
@@ -0,0 +1,259 @@
+package rest
+
+import (
+	"encoding/json"
+	"net/http"
+
+	requests "github.com/liuhengloveyou/okx-go/requests/rest/broker"
+	responses "github.com/liuhengloveyou/okx-go/responses/broker"
+)
+
+// Broker
+//
+// https://www.okx.com/docs-v5/en/#broker-api
+type Broker struct {
+	client *ClientRest
+}
+
+// NewBroker returns a pointer to a fresh Broker
+func NewBroker(c *ClientRest) *Broker {
+	return &Broker{c}
+}
+
+// CreateSubAccount
+// Create a sub-account of the ND broker.
+//
+// https://www.okx.com/docs-v5/en/#broker-api-create-sub-account
+func (c *Broker) CreateSubAccount(req requests.CreateSubAccount) (response responses.SubAccount, err error) {
+	p := "/api/v5/broker/nd/create-subaccount"
+	res, err := c.client.Do(http.MethodPost, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// DeleteSubAccount
+// Delete a sub-account of the ND broker, it must have no assets left.
+//
+// https://www.okx.com/docs-v5/en/#broker-api-delete-sub-account
+func (c *Broker) DeleteSubAccount(req requests.DeleteSubAccount) (response responses.Empty, err error) {
+	p := "/api/v5/broker/nd/delete-subaccount"
+	res, err := c.client.Do(http.MethodPost, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// GetSubAccountInfo
+// Retrieve the sub-accounts of the ND broker.
+//
+// https://www.okx.com/docs-v5/en/#broker-api-get-sub-account-list
+func (c *Broker) GetSubAccountInfo(req requests.SubAccountInfo) (response responses.SubAccountList, err error) {
+	p := "/api/v5/broker/nd/subaccount-info"
+	res, err := c.client.Do(http.MethodGet, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// CreateAPIKey
+// Create an API key for a sub-account.
+//
+// https://www.okx.com/docs-v5/en/#broker-api-create-an-api-key-for-a-sub-account
+func (c *Broker) CreateAPIKey(req requests.CreateAPIKey) (response responses.APIKey, err error) {
+	p := "/api/v5/broker/nd/subaccount/apikey"
+	res, err := c.client.Do(http.MethodPost, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// GetAPIKeys
+// Retrieve the API keys of a sub-account.
+//
+// https://www.okx.com/docs-v5/en/#broker-api-query-the-api-key-of-a-sub-account
+func (c *Broker) GetAPIKeys(req requests.QueryAPIKey) (response responses.APIKey, err error) {
+	p := "/api/v5/broker/nd/subaccount/apikey"
+	res, err := c.client.Do(http.MethodGet, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// ModifyAPIKey
+// Modify the label, permissions or IP addresses of a sub-account API key.
+//
+// https://www.okx.com/docs-v5/en/#broker-api-reset-the-api-key-of-a-sub-account
+func (c *Broker) ModifyAPIKey(req requests.ModifyAPIKey) (response responses.APIKey, err error) {
+	p := "/api/v5/broker/nd/subaccount/modify-apikey"
+	res, err := c.client.Do(http.MethodPost, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// DeleteAPIKey
+// Delete an API key of a sub-account.
+//
+// https://www.okx.com/docs-v5/en/#broker-api-delete-the-api-key-of-sub-accounts
+func (c *Broker) DeleteAPIKey(req requests.DeleteAPIKey) (response responses.Empty, err error) {
+	p := "/api/v5/broker/nd/subaccount/delete-apikey"
+	res, err := c.client.Do(http.MethodPost, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// SetSubAccountLevel
+// Set the account level of a sub-account.
+//
+// https://www.okx.com/docs-v5/en/#broker-api-set-the-account-level-of-a-sub-account
+func (c *Broker) SetSubAccountLevel(req requests.SetSubAccountLevel) (response responses.SubAccountLevel, err error) {
+	p := "/api/v5/broker/nd/set-subaccount-level"
+	res, err := c.client.Do(http.MethodPost, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// SetFeeRate
+// Set the trading fee rates of a sub-account.
+//
+// https://www.okx.com/docs-v5/en/#broker-api-set-trading-fee-rates-of-a-sub-account
+func (c *Broker) SetFeeRate(req requests.SetFeeRate) (response responses.FeeRate, err error) {
+	p := "/api/v5/broker/nd/set-subaccount-fee-rate"
+	res, err := c.client.Do(http.MethodPost, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// CreateDepositAddress
+// Create a deposit address for a sub-account.
+//
+// https://www.okx.com/docs-v5/en/#broker-api-create-deposit-address-for-a-sub-account
+func (c *Broker) CreateDepositAddress(req requests.CreateDepositAddress) (response responses.DepositAddress, err error) {
+	p := "/api/v5/broker/nd/subaccount-deposit-address"
+	res, err := c.client.Do(http.MethodPost, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// GetRebateDaily
+// Retrieve the daily rebates of the broker.
+//
+// https://www.okx.com/docs-v5/en/#broker-api-get-rebate-details-by-period
+func (c *Broker) GetRebateDaily(req requests.RebateDaily) (response responses.RebateDaily, err error) {
+	p := "/api/v5/broker/nd/rebate-daily"
+	res, err := c.client.Do(http.MethodGet, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// GenerateRebateReport
+// Request the generation of the per order rebate report of a period, retrieve it with GetRebateReport once ready.
+//
+// https://www.okx.com/docs-v5/en/#broker-api-generate-rebate-details-download-link
+func (c *Broker) GenerateRebateReport(req requests.GenerateRebateReport) (response responses.GenerateRebateReport, err error) {
+	p := "/api/v5/broker/nd/rebate-per-orders"
+	res, err := c.client.Do(http.MethodPost, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// GetRebateReport
+// Retrieve the download link of a per order rebate report.
+//
+// https://www.okx.com/docs-v5/en/#broker-api-get-download-link
+func (c *Broker) GetRebateReport(req requests.RebateReport) (response responses.RebateReport, err error) {
+	p := "/api/v5/broker/nd/rebate-per-orders"
+	res, err := c.client.Do(http.MethodGet, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// GetInviteeDetail
+// Retrieve the details of an invitee of the affiliate.
+//
+// https://www.okx.com/docs-v5/en/#affiliate-rest-api-get-the-invitee-39-s-detail
+func (c *Broker) GetInviteeDetail(req requests.InviteeDetail) (response responses.InviteeDetail, err error) {
+	p := "/api/v5/affiliate/invitee/detail"
+	res, err := c.client.Do(http.MethodGet, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
@@ -11,28 +11,44 @@ import (
 	"github.com/liuhengloveyou/okx-go"
 	requests "github.com/liuhengloveyou/okx-go/requests/rest/public"
 	responses "github.com/liuhengloveyou/okx-go/responses/public_data"
+	"github.com/liuhengloveyou/okx-go/risk"
 	"log"
 	"net"
 	"net/http"
-	"strings"
+	"sync"
 	"time"
 )
 
 // ClientRest is the rest api client
 type ClientRest struct {
-	Account     *Account
-	SubAccount  *SubAccount
-	Trade       *Trade
-	Funding     *Funding
-	Market      *Market
-	PublicData  *PublicData
-	TradeData   *TradeData
-	apiKey      string
-	secretKey   []byte
-	passphrase  string
-	destination okx.Destination
-	baseURL     okx.BaseURL
-	Client      *http.Client
+	Account      *Account
+	SubAccount   *SubAccount
+	Trade        *Trade
+	Funding      *Funding
+	Market       *Market
+	PublicData   *PublicData
+	TradeData    *TradeData
+	Spread       *Spread
+	BlockTrading *BlockTrading
+	TradingBot   *TradingBot
+	CopyTrading  *CopyTrading
+	Finance      *Finance
+	Convert      *Convert
+	Loan         *Loan
+	Broker       *Broker
+	apiKey       string
+	brokerTag    string
+	clOrdID      okx.ClOrdIDGenerator
+	idemOnce     sync.Once
+	idemClOrdID  okx.ClOrdIDGenerator
+	idemErr      error
+	risk         *risk.Gate
+	clock        okx.Clock
+	secretKey    []byte
+	passphrase   string
+	destination  okx.Destination
+	baseURL      okx.BaseURL
+	Client       *http.Client
 }
 
 // NewClient returns a pointer to a fresh ClientRest
@@ -52,6 +68,14 @@ func NewClient(apiKey, secretKey, passphrase string, baseURL okx.BaseURL, destin
 	c.Market = NewMarket(c)
 	c.PublicData = NewPublicData(c)
 	c.TradeData = NewTradeData(c)
+	c.Spread = NewSpread(c)
+	c.BlockTrading = NewBlockTrading(c)
+	c.TradingBot = NewTradingBot(c)
+	c.CopyTrading = NewCopyTrading(c)
+	c.Finance = NewFinance(c)
+	c.Convert = NewConvert(c)
+	c.Loan = NewLoan(c)
+	c.Broker = NewBroker(c)
 	return c
 }
 
@@ -94,11 +118,27 @@ func NewClientWithIP(apiKey, secretKey, passphrase string, baseURL okx.BaseURL,
 	c.Market = NewMarket(c)
 	c.PublicData = NewPublicData(c)
 	c.TradeData = NewTradeData(c)
+	c.Spread = NewSpread(c)
+	c.BlockTrading = NewBlockTrading(c)
+	c.TradingBot = NewTradingBot(c)
+	c.CopyTrading = NewCopyTrading(c)
+	c.Finance = NewFinance(c)
+	c.Convert = NewConvert(c)
+	c.Loan = NewLoan(c)
+	c.Broker = NewBroker(c)
 	return c
 }
 
 // Do the http request to the server
-func (c *ClientRest) Do(method, path string, private bool, params ...map[string]string) (*http.Response, error) {
+//
+// The optional params is encoded with okx.EncodeQuery into the query string of GET requests and with okx.EncodeBody into the JSON body of the others.
+func (c *ClientRest) Do(method, path string, private bool, params ...interface{}) (*http.Response, error) {
+	return c.DoWithOptions(method, path, private, okx.RequestOptions{}, params...)
+}
+
+// DoWithOptions does the http request to the server like Do, adding the headers of opts and its expTime, if any.
+// The reserved headers of opts are skipped, see okx.ReservedHeader.
+func (c *ClientRest) DoWithOptions(method, path string, private bool, opts okx.RequestOptions, params ...interface{}) (*http.Response, error) {
 	u := fmt.Sprintf("%s%s", c.baseURL, path)
 	var (
 		r    *http.Request
@@ -113,17 +153,28 @@ func (c *ClientRest) Do(method, path string, private bool, params ...map[string]
 		}
 
 		if len(params) > 0 {
+			m, err := okx.EncodeQuery(params[0])
+			if err != nil {
+				return nil, err
+			}
 			q := r.URL.Query()
-			for k, v := range params[0] {
-				q.Add(k, strings.ReplaceAll(v, "\"", ""))
+			for k, v := range m {
+				q.Add(k, v)
 			}
 			r.URL.RawQuery = q.Encode()
-			if len(params[0]) > 0 {
+			if len(m) > 0 {
 				path += "?" + r.URL.RawQuery
 			}
 		}
 	} else {
-		j, err = json.Marshal(params[0])
+		var b interface{} = map[string]interface{}{}
+		if len(params) > 0 {
+			b, err = okx.EncodeBody(params[0])
+			if err != nil {
+				return nil, err
+			}
+		}
+		j, err = json.Marshal(b)
 		if err != nil {
 			return nil, err
 		}
@@ -137,9 +188,6 @@ func (c *ClientRest) Do(method, path string, private bool, params ...map[string]
 		}
 		r.Header.Add("Content-Type", "application/json")
 	}
-	if err != nil {
-		return nil, err
-	}
 	if private {
 		timestamp, sign := c.sign(method, path, body)
 		r.Header.Add("OK-ACCESS-KEY", c.apiKey)
@@ -150,48 +198,66 @@ func (c *ClientRest) Do(method, path string, private bool, params ...map[string]
 	if c.destination == okx.DemoServer {
 		r.Header.Add("x-simulated-trading", "1")
 	}
+	for k, v := range opts.Header {
+		if !okx.ReservedHeader(k) {
+			r.Header.Set(k, v)
+		}
+	}
+	if exp := opts.ExpiresAt(&c.clock); !exp.IsZero() {
+		r.Header.Set("expTime", okx.FormatExpTime(exp))
+	}
 	return c.Client.Do(r)
 }
 
 // DoBatch the private post request to the server with parameters of type slice
 func (c *ClientRest) DoBatch(path string, params interface{}) (*http.Response, error) {
-	method := "POST"
-	u := fmt.Sprintf("%s%s", c.baseURL, path)
-	var (
-		r    *http.Request
-		err  error
-		j    []byte
-		body string
-	)
+	return c.Do(http.MethodPost, path, true, params)
+}
 
-	j, err = json.Marshal(params)
-	if err != nil {
-		return nil, err
-	}
-	body = string(j)
-	if body == "{}" || body == "[]" {
-		body = ""
-	}
-	r, err = http.NewRequest(method, u, bytes.NewBuffer(j))
-	if err != nil {
-		return nil, err
-	}
-	r.Header.Add("Content-Type", "application/json")
+// SetBrokerTag set the broker tag stamped on every order placed through Trade or Spread whose Tag is left empty
+func (c *ClientRest) SetBrokerTag(tag string) {
+	c.brokerTag = tag
+}
 
-	if err != nil {
-		return nil, err
+// SetClOrdIDGenerator set the generator of the ClOrdID of every order placed through Trade or Spread whose ClOrdID is left empty, such as okx.NewClOrdIDGenerator
+func (c *ClientRest) SetClOrdIDGenerator(gen okx.ClOrdIDGenerator) {
+	c.clOrdID = gen
+}
+
+// SetRiskGate set the gate checking every order placed or amended through Trade, Spread, CopyTrading and TradingBot
+// before it's sent, nil disables it
+func (c *ClientRest) SetRiskGate(g *risk.Gate) {
+	c.risk = g
+}
+
+// release releases from the risk gate the orders that failed to be sent,
+// unless err leaves unknown whether OKX got them and the orders channel has yet to tell
+func (c *ClientRest) release(err error, orders ...risk.Order) {
+	if c.risk == nil || err == nil || unknownOutcome(err) {
+		return
 	}
+	c.risk.Release(orders...)
+}
 
-	timestamp, sign := c.sign(method, path, body)
-	r.Header.Add("OK-ACCESS-KEY", c.apiKey)
-	r.Header.Add("OK-ACCESS-PASSPHRASE", c.passphrase)
-	r.Header.Add("OK-ACCESS-SIGN", sign)
-	r.Header.Add("OK-ACCESS-TIMESTAMP", timestamp)
+// Clock returns the clock of the server, as last synced with SyncTime
+func (c *ClientRest) Clock() *okx.Clock {
+	return &c.clock
+}
 
-	if c.destination == okx.DemoServer {
-		r.Header.Add("x-simulated-trading", "1")
+// SyncTime set the offset of Clock from the server time, it's used to compute the expTime of requests sent with okx.WithExpiry
+func (c *ClientRest) SyncTime() (offset time.Duration, err error) {
+	sent := time.Now()
+	res, err := c.PublicData.GetSystemTime()
+	if err != nil {
+		return
 	}
-	return c.Client.Do(r)
+	received := time.Now()
+	if res.Code != 0 || len(res.SystemTimes) == 0 {
+		err = fmt.Errorf("okx: get system time failed, code %d: %s", res.Code, res.Msg)
+		return
+	}
+
+	return c.clock.Sync(time.Time(res.SystemTimes[0].TS), sent, received), nil
 }
 
 // Status
@@ -200,8 +266,7 @@ func (c *ClientRest) DoBatch(path string, params interface{}) (*http.Response, e
 // https://www.okx.com/docs-v5/en/#rest-api-status
 func (c *ClientRest) Status(req requests.Status) (response responses.Status, err error) {
 	p := "/api/v5/system/status"
-	m := okx.S2M(req)
-	res, err := c.Do(http.MethodGet, p, false, m)
+	res, err := c.Do(http.MethodGet, p, false, req)
 	if err != nil {
 		return
 	}
@@ -0,0 +1,166 @@
+package rest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	models "github.com/liuhengloveyou/okx-go/models/convert"
+	requests "github.com/liuhengloveyou/okx-go/requests/rest/convert"
+	responses "github.com/liuhengloveyou/okx-go/responses/convert"
+)
+
+// Convert
+//
+// https://www.okx.com/docs-v5/en/#funding-account-rest-api-get-convert-currencies
+type Convert struct {
+	client *ClientRest
+}
+
+// NewConvert returns a pointer to a fresh Convert
+func NewConvert(c *ClientRest) *Convert {
+	return &Convert{c}
+}
+
+// GetCurrencies
+// Retrieve the currencies available for convert, with their min and max amounts.
+//
+// https://www.okx.com/docs-v5/en/#funding-account-rest-api-get-convert-currencies
+func (c *Convert) GetCurrencies() (response responses.Currencies, err error) {
+	p := "/api/v5/asset/convert/currencies"
+	res, err := c.client.Do(http.MethodGet, p, true)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// GetCurrencyPair
+// Retrieve the convert limits of a currency pair.
+//
+// https://www.okx.com/docs-v5/en/#funding-account-rest-api-get-convert-currency-pair
+func (c *Convert) GetCurrencyPair(req requests.CurrencyPair) (response responses.CurrencyPair, err error) {
+	p := "/api/v5/asset/convert/currency-pair"
+	res, err := c.client.Do(http.MethodGet, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// EstimateQuote
+// Request a quote, valid for TTLMs milliseconds.
+//
+// https://www.okx.com/docs-v5/en/#funding-account-rest-api-estimate-quote
+func (c *Convert) EstimateQuote(req requests.EstimateQuote) (response responses.EstimateQuote, err error) {
+	p := "/api/v5/asset/convert/estimate-quote"
+	res, err := c.client.Do(http.MethodPost, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// Trade
+// Convert at the price of a quote, it must be done before the quote expires.
+//
+// https://www.okx.com/docs-v5/en/#funding-account-rest-api-convert-trade
+func (c *Convert) Trade(req requests.Trade) (response responses.Trade, err error) {
+	p := "/api/v5/asset/convert/trade"
+	res, err := c.client.Do(http.MethodPost, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// GetHistory
+// Retrieve the convert trades of the last 3 months.
+//
+// https://www.okx.com/docs-v5/en/#funding-account-rest-api-get-convert-history
+func (c *Convert) GetHistory(req requests.History) (response responses.Trade, err error) {
+	p := "/api/v5/asset/convert/history"
+	res, err := c.client.Do(http.MethodGet, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// ErrQuoteExpired is returned by QuoteAndTrade when the quote expired, or was about to, before the trade could be sent
+var ErrQuoteExpired = errors.New("okx: convert quote expired")
+
+// ConvertResult is the outcome of QuoteAndTrade
+type ConvertResult struct {
+	Quote *models.Quote
+	Trade *models.Trade
+}
+
+// QuoteAndTrade requests a quote and converts at its price right away.
+//
+// The lifetime of the quote is counted from the moment it was requested, so clock skew with the server doesn't matter.
+// The trade isn't sent and ErrQuoteExpired is returned when less than minTTL remains.
+// Failures reported by OKX, including a trade that wasn't fully filled, are returned as errors along with what was received.
+func (c *Convert) QuoteAndTrade(req requests.EstimateQuote, minTTL time.Duration) (result ConvertResult, err error) {
+	requested := time.Now()
+	q, err := c.EstimateQuote(req)
+	if err != nil {
+		return
+	}
+	if q.Code != 0 || len(q.Quotes) == 0 {
+		err = fmt.Errorf("okx: convert estimate quote failed, code %d: %s", q.Code, q.Msg)
+		return
+	}
+	result.Quote = q.Quotes[0]
+
+	expiry := requested.Add(time.Duration(result.Quote.TTLMs) * time.Millisecond)
+	if time.Until(expiry) < minTTL {
+		err = ErrQuoteExpired
+		return
+	}
+
+	t, err := c.Trade(requests.Trade{
+		QuoteID:  result.Quote.QuoteID,
+		BaseCcy:  result.Quote.BaseCcy,
+		QuoteCcy: result.Quote.QuoteCcy,
+		Side:     result.Quote.Side,
+		Sz:       float64(result.Quote.RfqSz),
+		SzCcy:    result.Quote.RfqSzCcy,
+		Tag:      req.Tag,
+	})
+	if err != nil {
+		return
+	}
+	if t.Code != 0 || len(t.Trades) == 0 {
+		err = fmt.Errorf("okx: convert trade failed, code %d: %s", t.Code, t.Msg)
+		return
+	}
+	result.Trade = t.Trades[0]
+	if result.Trade.State != "fullyFilled" {
+		err = fmt.Errorf("okx: convert trade %s of quote %s is %s", result.Trade.TradeID, result.Quote.QuoteID, result.Trade.State)
+	}
+
+	return
+}
@@ -0,0 +1,263 @@
+package rest
+
+import (
+	"encoding/json"
+	"net/http"
+
+	requests "github.com/liuhengloveyou/okx-go/requests/rest/copytrading"
+	tradeRequests "github.com/liuhengloveyou/okx-go/requests/rest/trade"
+	responses "github.com/liuhengloveyou/okx-go/responses/copy_trading"
+	tradeResponses "github.com/liuhengloveyou/okx-go/responses/trade"
+)
+
+// CopyTrading
+//
+// https://www.okx.com/docs-v5/en/#order-book-trading-copy-trading
+type CopyTrading struct {
+	client *ClientRest
+}
+
+// NewCopyTrading returns a pointer to a fresh CopyTrading
+func NewCopyTrading(c *ClientRest) *CopyTrading {
+	return &CopyTrading{c}
+}
+
+// GetSubPositions
+// Retrieve the lead positions that are not closed yet.
+//
+// https://www.okx.com/docs-v5/en/#order-book-trading-copy-trading-get-existing-lead-positions
+func (c *CopyTrading) GetSubPositions(req requests.SubPositions) (response responses.SubPositions, err error) {
+	p := "/api/v5/copytrading/current-subpositions"
+	res, err := c.client.Do(http.MethodGet, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// GetSubPositionsHistory
+// Retrieve the lead positions completed in the last 3 months.
+//
+// https://www.okx.com/docs-v5/en/#order-book-trading-copy-trading-get-lead-position-history
+func (c *CopyTrading) GetSubPositionsHistory(req requests.SubPositions) (response responses.SubPositions, err error) {
+	p := "/api/v5/copytrading/subpositions-history"
+	res, err := c.client.Do(http.MethodGet, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// PlaceSubPosition
+// Open a lead position, OKX has no dedicated endpoint for it: it's a regular order on an instrument enabled for leading,
+// placed with Trade.PlaceOrder.
+//
+// https://www.okx.com/docs-v5/en/#order-book-trading-trade-post-place-order
+func (c *CopyTrading) PlaceSubPosition(req requests.PlaceSubPosition) (response tradeResponses.PlaceOrder, err error) {
+	return c.client.Trade.PlaceOrder(tradeRequests.PlaceOrder{
+		InstID:  req.InstID,
+		ClOrdID: req.ClOrdID,
+		Tag:     req.Tag,
+		Sz:      req.Sz,
+		Px:      req.Px,
+		TdMode:  req.TdMode,
+		Side:    req.Side,
+		PosSide: req.PosSide,
+		OrdType: req.OrdType,
+	})
+}
+
+// PlaceSubPositionTpSl
+// Set the take profit and stop loss of a lead position, setting a price to 0 removes it.
+//
+// https://www.okx.com/docs-v5/en/#order-book-trading-copy-trading-post-place-lead-stop-order
+func (c *CopyTrading) PlaceSubPositionTpSl(req requests.SubPositionTpSl) (response responses.SubPositionResult, err error) {
+	p := "/api/v5/copytrading/algo-order"
+	res, err := c.client.Do(http.MethodPost, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// CloseSubPosition
+// Close a lead position at market or limit price.
+//
+// https://www.okx.com/docs-v5/en/#order-book-trading-copy-trading-post-close-lead-position
+func (c *CopyTrading) CloseSubPosition(req requests.CloseSubPosition) (response responses.SubPositionResult, err error) {
+	p := "/api/v5/copytrading/close-subposition"
+	res, err := c.client.Do(http.MethodPost, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// GetLeadInstruments
+// Retrieve the instruments that can be lead, with whether they are enabled for the lead trader.
+//
+// https://www.okx.com/docs-v5/en/#order-book-trading-copy-trading-get-leading-instruments
+func (c *CopyTrading) GetLeadInstruments(req requests.LeadInstruments) (response responses.LeadInstruments, err error) {
+	p := "/api/v5/copytrading/instruments"
+	res, err := c.client.Do(http.MethodGet, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// SetLeadInstruments
+// Set the instruments the lead trader leads on, the new list replaces the current one.
+//
+// https://www.okx.com/docs-v5/en/#order-book-trading-copy-trading-post-amend-leading-instruments
+func (c *CopyTrading) SetLeadInstruments(req requests.SetLeadInstruments) (response responses.LeadInstruments, err error) {
+	p := "/api/v5/copytrading/set-instruments"
+	res, err := c.client.Do(http.MethodPost, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// GetProfitSharingDetails
+// Retrieve the profit sharing details of the last 3 months.
+//
+// https://www.okx.com/docs-v5/en/#order-book-trading-copy-trading-get-profit-sharing-details
+func (c *CopyTrading) GetProfitSharingDetails(req requests.ProfitSharingDetails) (response responses.ProfitSharingDetails, err error) {
+	p := "/api/v5/copytrading/profit-sharing-details"
+	res, err := c.client.Do(http.MethodGet, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// GetTotalProfitSharing
+// Retrieve the total profit shared since joining the platform.
+//
+// https://www.okx.com/docs-v5/en/#order-book-trading-copy-trading-get-total-profit-sharing
+func (c *CopyTrading) GetTotalProfitSharing(req requests.ProfitSharing) (response responses.TotalProfitSharing, err error) {
+	p := "/api/v5/copytrading/total-profit-sharing"
+	res, err := c.client.Do(http.MethodGet, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// GetUnrealizedProfitSharing
+// Retrieve the profit expected to be shared with the lead trader.
+//
+// https://www.okx.com/docs-v5/en/#order-book-trading-copy-trading-get-unrealized-profit-sharing-details
+func (c *CopyTrading) GetUnrealizedProfitSharing(req requests.ProfitSharing) (response responses.UnrealizedProfitSharing, err error) {
+	p := "/api/v5/copytrading/unrealized-profit-sharing-details"
+	res, err := c.client.Do(http.MethodGet, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// GetCopyTraders
+// Retrieve the followers of the lead trader.
+//
+// https://www.okx.com/docs-v5/en/#order-book-trading-copy-trading-get-copy-traders
+func (c *CopyTrading) GetCopyTraders(req requests.CopyTraders) (response responses.CopyTraders, err error) {
+	p := "/api/v5/copytrading/copy-traders"
+	res, err := c.client.Do(http.MethodGet, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// GetPublicCopyTraders
+// Retrieve the followers of any lead trader.
+//
+// https://www.okx.com/docs-v5/en/#order-book-trading-copy-trading-get-copy-traders-public
+func (c *CopyTrading) GetPublicCopyTraders(req requests.CopyTraders) (response responses.CopyTraders, err error) {
+	p := "/api/v5/copytrading/public-copy-traders"
+	res, err := c.client.Do(http.MethodGet, p, false, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// GetPublicLeadTraders
+// Retrieve the ranking of the lead traders.
+//
+// https://www.okx.com/docs-v5/en/#order-book-trading-copy-trading-get-lead-trader-ranks-public
+func (c *CopyTrading) GetPublicLeadTraders(req requests.LeadTraders) (response responses.LeadTraders, err error) {
+	p := "/api/v5/copytrading/public-lead-traders"
+	res, err := c.client.Do(http.MethodGet, p, false, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// GetPublicLeadTraderStats
+// Retrieve the statistics of a lead trader.
+//
+// https://www.okx.com/docs-v5/en/#order-book-trading-copy-trading-get-lead-trader-stats-public
+func (c *CopyTrading) GetPublicLeadTraderStats(req requests.LeadTraderStats) (response responses.LeadTraderStats, err error) {
+	p := "/api/v5/copytrading/public-stats"
+	res, err := c.client.Do(http.MethodGet, p, false, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
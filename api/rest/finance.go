@@ -0,0 +1,385 @@
+package rest
+
+import (
+	"encoding/json"
+	"net/http"
+
+	requests "github.com/liuhengloveyou/okx-go/requests/rest/finance"
+	responses "github.com/liuhengloveyou/okx-go/responses/finance"
+)
+
+// Finance
+//
+// https://www.okx.com/docs-v5/en/#financial-product
+type Finance struct {
+	client *ClientRest
+}
+
+// NewFinance returns a pointer to a fresh Finance
+func NewFinance(c *ClientRest) *Finance {
+	return &Finance{c}
+}
+
+// GetSavingsBalance
+// Retrieve the simple earn flexible balance.
+//
+// https://www.okx.com/docs-v5/en/#financial-product-savings-get-saving-balance
+func (c *Finance) GetSavingsBalance(req requests.SavingsBalance) (response responses.SavingsBalance, err error) {
+	p := "/api/v5/finance/savings/balance"
+	res, err := c.client.Do(http.MethodGet, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// SavingsPurchaseRedemption
+// Purchase or redeem simple earn flexible, only the funds of the funding account can be used.
+//
+// https://www.okx.com/docs-v5/en/#financial-product-savings-post-savings-purchase-redemption
+func (c *Finance) SavingsPurchaseRedemption(req requests.SavingsPurchaseRedemption) (response responses.SavingsPurchaseRedemption, err error) {
+	p := "/api/v5/finance/savings/purchase-redempt"
+	res, err := c.client.Do(http.MethodPost, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// SetLendingRate
+// Set the minimum lending rate of simple earn flexible.
+//
+// https://www.okx.com/docs-v5/en/#financial-product-savings-post-set-lending-rate
+func (c *Finance) SetLendingRate(req requests.SetLendingRate) (response responses.LendingRate, err error) {
+	p := "/api/v5/finance/savings/set-lending-rate"
+	res, err := c.client.Do(http.MethodPost, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// GetLendingHistory
+// Retrieve the lending history of the last month.
+//
+// https://www.okx.com/docs-v5/en/#financial-product-savings-get-lending-history
+func (c *Finance) GetLendingHistory(req requests.LendingHistory) (response responses.LendingHistory, err error) {
+	p := "/api/v5/finance/savings/lending-history"
+	res, err := c.client.Do(http.MethodGet, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// GetLendingRateSummary
+// Retrieve the public borrow info of simple earn flexible.
+//
+// https://www.okx.com/docs-v5/en/#financial-product-savings-get-public-borrow-info-public
+func (c *Finance) GetLendingRateSummary(req requests.LendingRateSummary) (response responses.LendingRateSummary, err error) {
+	p := "/api/v5/finance/savings/lending-rate-summary"
+	res, err := c.client.Do(http.MethodGet, p, false, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// GetLendingRateHistory
+// Retrieve the public borrow history of simple earn flexible.
+//
+// https://www.okx.com/docs-v5/en/#financial-product-savings-get-public-borrow-history-public
+func (c *Finance) GetLendingRateHistory(req requests.LendingHistory) (response responses.PublicLendingRate, err error) {
+	p := "/api/v5/finance/savings/lending-rate-history"
+	res, err := c.client.Do(http.MethodGet, p, false, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// GetStakingOffers
+// Retrieve the on-chain earn offers.
+//
+// https://www.okx.com/docs-v5/en/#financial-product-on-chain-earn-get-offers
+func (c *Finance) GetStakingOffers(req requests.StakingOffers) (response responses.StakingOffers, err error) {
+	p := "/api/v5/finance/staking-defi/offers"
+	res, err := c.client.Do(http.MethodGet, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// StakingPurchase
+// Purchase an on-chain earn offer.
+//
+// https://www.okx.com/docs-v5/en/#financial-product-on-chain-earn-post-purchase
+func (c *Finance) StakingPurchase(req requests.StakingPurchase) (response responses.StakingOrderResult, err error) {
+	p := "/api/v5/finance/staking-defi/purchase"
+	res, err := c.client.Do(http.MethodPost, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// StakingRedeem
+// Redeem an on-chain earn order.
+//
+// https://www.okx.com/docs-v5/en/#financial-product-on-chain-earn-post-redeem
+func (c *Finance) StakingRedeem(req requests.StakingRedeem) (response responses.StakingOrderResult, err error) {
+	p := "/api/v5/finance/staking-defi/redeem"
+	res, err := c.client.Do(http.MethodPost, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// StakingCancel
+// Cancel an on-chain earn purchase or redemption still pending.
+//
+// https://www.okx.com/docs-v5/en/#financial-product-on-chain-earn-post-cancel-purchases-redemptions
+func (c *Finance) StakingCancel(req requests.StakingCancel) (response responses.StakingOrderResult, err error) {
+	p := "/api/v5/finance/staking-defi/cancel"
+	res, err := c.client.Do(http.MethodPost, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// GetStakingOrders
+// Retrieve the active on-chain earn orders.
+//
+// https://www.okx.com/docs-v5/en/#financial-product-on-chain-earn-get-active-orders
+//
+// Retrieve the completed on-chain earn orders.
+//
+// https://www.okx.com/docs-v5/en/#financial-product-on-chain-earn-get-order-history
+func (c *Finance) GetStakingOrders(req requests.StakingOrders, arch bool) (response responses.StakingOrders, err error) {
+	p := "/api/v5/finance/staking-defi/orders-active"
+	if arch {
+		p = "/api/v5/finance/staking-defi/orders-history"
+	}
+	res, err := c.client.Do(http.MethodGet, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// ETHStakingPurchase
+// Stake ETH, BETH is received in exchange.
+//
+// https://www.okx.com/docs-v5/en/#financial-product-eth-staking-post-purchase
+func (c *Finance) ETHStakingPurchase(req requests.CoinStaking) (response responses.CoinStaking, err error) {
+	p := "/api/v5/finance/staking-defi/eth/purchase"
+	res, err := c.client.Do(http.MethodPost, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// ETHStakingRedeem
+// Redeem staked ETH.
+//
+// https://www.okx.com/docs-v5/en/#financial-product-eth-staking-post-redeem
+func (c *Finance) ETHStakingRedeem(req requests.CoinStaking) (response responses.CoinStaking, err error) {
+	p := "/api/v5/finance/staking-defi/eth/redeem"
+	res, err := c.client.Do(http.MethodPost, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// ETHStakingBalance
+// Retrieve the ETH staking balance and its interests.
+//
+// https://www.okx.com/docs-v5/en/#financial-product-eth-staking-get-balance
+func (c *Finance) ETHStakingBalance() (response responses.CoinStakingBalance, err error) {
+	p := "/api/v5/finance/staking-defi/eth/balance"
+	res, err := c.client.Do(http.MethodGet, p, true)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// ETHStakingHistory
+// Retrieve the ETH staking purchases and redemptions.
+//
+// https://www.okx.com/docs-v5/en/#financial-product-eth-staking-get-purchase-amp-redeem-history
+func (c *Finance) ETHStakingHistory(req requests.CoinStakingHistory) (response responses.CoinStakingHistory, err error) {
+	p := "/api/v5/finance/staking-defi/eth/purchase-redeem-history"
+	res, err := c.client.Do(http.MethodGet, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// ETHStakingApyHistory
+// Retrieve the ETH staking APY of the last days.
+//
+// https://www.okx.com/docs-v5/en/#financial-product-eth-staking-get-apy-history-public
+func (c *Finance) ETHStakingApyHistory(req requests.CoinStakingApy) (response responses.CoinStakingApy, err error) {
+	p := "/api/v5/finance/staking-defi/eth/apy-history"
+	res, err := c.client.Do(http.MethodGet, p, false, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// SOLStakingPurchase
+// Stake SOL, OKSOL is received in exchange.
+//
+// https://www.okx.com/docs-v5/en/#financial-product-sol-staking-post-purchase
+func (c *Finance) SOLStakingPurchase(req requests.CoinStaking) (response responses.CoinStaking, err error) {
+	p := "/api/v5/finance/staking-defi/sol/purchase"
+	res, err := c.client.Do(http.MethodPost, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// SOLStakingRedeem
+// Redeem staked SOL.
+//
+// https://www.okx.com/docs-v5/en/#financial-product-sol-staking-post-redeem
+func (c *Finance) SOLStakingRedeem(req requests.CoinStaking) (response responses.CoinStaking, err error) {
+	p := "/api/v5/finance/staking-defi/sol/redeem"
+	res, err := c.client.Do(http.MethodPost, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// SOLStakingBalance
+// Retrieve the SOL staking balance and its interests.
+//
+// https://www.okx.com/docs-v5/en/#financial-product-sol-staking-get-balance
+func (c *Finance) SOLStakingBalance() (response responses.CoinStakingBalance, err error) {
+	p := "/api/v5/finance/staking-defi/sol/balance"
+	res, err := c.client.Do(http.MethodGet, p, true)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// SOLStakingHistory
+// Retrieve the SOL staking purchases and redemptions.
+//
+// https://www.okx.com/docs-v5/en/#financial-product-sol-staking-get-purchase-amp-redeem-history
+func (c *Finance) SOLStakingHistory(req requests.CoinStakingHistory) (response responses.CoinStakingHistory, err error) {
+	p := "/api/v5/finance/staking-defi/sol/purchase-redeem-history"
+	res, err := c.client.Do(http.MethodGet, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// SOLStakingApyHistory
+// Retrieve the SOL staking APY of the last days.
+//
+// https://www.okx.com/docs-v5/en/#financial-product-sol-staking-get-apy-history-public
+func (c *Finance) SOLStakingApyHistory(req requests.CoinStakingApy) (response responses.CoinStakingApy, err error) {
+	p := "/api/v5/finance/staking-defi/sol/apy-history"
+	res, err := c.client.Do(http.MethodGet, p, false, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
@@ -2,11 +2,9 @@ package rest
 
 import (
 	"encoding/json"
-	"github.com/liuhengloveyou/okx-go"
 	requests "github.com/liuhengloveyou/okx-go/requests/rest/funding"
 	responses "github.com/liuhengloveyou/okx-go/responses/funding"
 	"net/http"
-	"strings"
 )
 
 // Funding
@@ -46,11 +44,7 @@ func (c *Funding) GetCurrencies() (response responses.GetCurrencies, err error)
 // https://www.okx.com/docs-v5/en/#rest-api-funding-get-balance
 func (c *Funding) GetBalance(req requests.GetBalance) (response responses.GetBalance, err error) {
 	p := "/api/v5/asset/balances"
-	m := okx.S2M(req)
-	if len(req.Ccy) > 0 {
-		m["ccy"] = strings.Join(req.Ccy, ",")
-	}
-	res, err := c.client.Do(http.MethodGet, p, true, m)
+	res, err := c.client.Do(http.MethodGet, p, true, req)
 	if err != nil {
 		return
 	}
@@ -66,8 +60,7 @@ func (c *Funding) GetBalance(req requests.GetBalance) (response responses.GetBal
 // https://www.okx.com/docs-v5/en/#rest-api-funding-funds-transfer
 func (c *Funding) FundsTransfer(req requests.FundsTransfer) (response responses.FundsTransfer, err error) {
 	p := "/api/v5/asset/transfer"
-	m := okx.S2M(req)
-	res, err := c.client.Do(http.MethodPost, p, true, m)
+	res, err := c.client.Do(http.MethodPost, p, true, req)
 	if err != nil {
 		return
 	}
@@ -82,8 +75,7 @@ func (c *Funding) FundsTransfer(req requests.FundsTransfer) (response responses.
 // https://www.okx.com/docs-v5/en/#funding-account-rest-api-get-funds-transfer-state
 func (c *Funding) FundsTransferState(req requests.FundsTransferState) (response responses.FundsTransferState, err error) {
 	p := "/api/v5/asset/transfer-state"
-	m := okx.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, true, m)
+	res, err := c.client.Do(http.MethodGet, p, true, req)
 	if err != nil {
 		return
 	}
@@ -99,8 +91,7 @@ func (c *Funding) FundsTransferState(req requests.FundsTransferState) (response
 // https://www.okx.com/docs-v5/en/#rest-api-funding-asset-bills-details
 func (c *Funding) AssetBillsDetails(req requests.AssetBillsDetails) (response responses.AssetBillsDetails, err error) {
 	p := "/api/v5/asset/bills"
-	m := okx.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, true, m)
+	res, err := c.client.Do(http.MethodGet, p, true, req)
 	if err != nil {
 		return
 	}
@@ -116,8 +107,7 @@ func (c *Funding) AssetBillsDetails(req requests.AssetBillsDetails) (response re
 // https://www.okx.com/docs-v5/en/#rest-api-funding-get-deposit-address
 func (c *Funding) GetDepositAddress(req requests.GetDepositAddress) (response responses.GetDepositAddress, err error) {
 	p := "/api/v5/asset/deposit-address"
-	m := okx.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, true, m)
+	res, err := c.client.Do(http.MethodGet, p, true, req)
 	if err != nil {
 		return
 	}
@@ -133,8 +123,7 @@ func (c *Funding) GetDepositAddress(req requests.GetDepositAddress) (response re
 // https://www.okx.com/docs-v5/en/#rest-api-funding-get-deposit-history
 func (c *Funding) GetDepositHistory(req requests.GetDepositHistory) (response responses.GetDepositHistory, err error) {
 	p := "/api/v5/asset/deposit-history"
-	m := okx.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, true, m)
+	res, err := c.client.Do(http.MethodGet, p, true, req)
 	if err != nil {
 		return
 	}
@@ -150,8 +139,7 @@ func (c *Funding) GetDepositHistory(req requests.GetDepositHistory) (response re
 // https://www.okx.com/docs-v5/en/#rest-api-funding-withdrawal
 func (c *Funding) Withdrawal(req requests.Withdrawal) (response responses.Withdrawal, err error) {
 	p := "/api/v5/asset/withdrawal"
-	m := okx.S2M(req)
-	res, err := c.client.Do(http.MethodPost, p, true, m)
+	res, err := c.client.Do(http.MethodPost, p, true, req)
 	if err != nil {
 		return
 	}
@@ -167,8 +155,7 @@ func (c *Funding) Withdrawal(req requests.Withdrawal) (response responses.Withdr
 // https://www.okx.com/docs-v5/en/#rest-api-funding-get-withdrawal-history
 func (c *Funding) GetWithdrawalHistory(req requests.GetWithdrawalHistory) (response responses.GetWithdrawalHistory, err error) {
 	p := "/api/v5/asset/withdrawal-history"
-	m := okx.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, true, m)
+	res, err := c.client.Do(http.MethodGet, p, true, req)
 	if err != nil {
 		return
 	}
@@ -183,8 +170,7 @@ func (c *Funding) GetWithdrawalHistory(req requests.GetWithdrawalHistory) (respo
 // https://www.okx.com/docs-v5/en/#rest-api-funding-piggybank-purchase-redemption
 func (c *Funding) PiggyBankPurchaseRedemption(req requests.PiggyBankPurchaseRedemption) (response responses.PiggyBankPurchaseRedemption, err error) {
 	p := "/api/v5/asset/purchase_redempt"
-	m := okx.S2M(req)
-	res, err := c.client.Do(http.MethodPost, p, true, m)
+	res, err := c.client.Do(http.MethodPost, p, true, req)
 	if err != nil {
 		return
 	}
@@ -199,8 +185,7 @@ func (c *Funding) PiggyBankPurchaseRedemption(req requests.PiggyBankPurchaseRede
 // https://www.okx.com/docs-v5/en/#rest-api-funding-get-piggybank-balance
 func (c *Funding) GetPiggyBankBalance(req requests.GetPiggyBankBalance) (response responses.GetPiggyBankBalance, err error) {
 	p := "/api/v5/asset/piggy-balance"
-	m := okx.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, true, m)
+	res, err := c.client.Do(http.MethodGet, p, true, req)
 	if err != nil {
 		return
 	}
@@ -0,0 +1,242 @@
+package rest
+
+import (
+	"encoding/json"
+	"net/http"
+
+	requests "github.com/liuhengloveyou/okx-go/requests/rest/loan"
+	responses "github.com/liuhengloveyou/okx-go/responses/loan"
+)
+
+// Loan
+//
+// https://www.okx.com/docs-v5/en/#financial-product-flexible-loan
+type Loan struct {
+	client *ClientRest
+}
+
+// NewLoan returns a pointer to a fresh Loan
+func NewLoan(c *ClientRest) *Loan {
+	return &Loan{c}
+}
+
+// GetBorrowCurrencies
+// Retrieve the currencies that can be borrowed with a flexible loan.
+//
+// https://www.okx.com/docs-v5/en/#financial-product-flexible-loan-get-borrowable-currencies
+func (c *Loan) GetBorrowCurrencies() (response responses.BorrowCurrencies, err error) {
+	p := "/api/v5/finance/flexible-loan/borrow-currencies"
+	res, err := c.client.Do(http.MethodGet, p, true)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// GetCollateralAssets
+// Retrieve the assets of the funding account that can be used as collateral.
+//
+// https://www.okx.com/docs-v5/en/#financial-product-flexible-loan-get-collateral-assets
+func (c *Loan) GetCollateralAssets(req requests.CollateralAssets) (response responses.CollateralAssets, err error) {
+	p := "/api/v5/finance/flexible-loan/collateral-assets"
+	res, err := c.client.Do(http.MethodGet, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// GetMaxLoan
+// Retrieve the maximum amount that can be borrowed, with the supplementary collateral if any.
+//
+// https://www.okx.com/docs-v5/en/#financial-product-flexible-loan-post-maximum-loan-amount
+func (c *Loan) GetMaxLoan(req requests.MaxLoan) (response responses.MaxLoan, err error) {
+	p := "/api/v5/finance/flexible-loan/max-loan"
+	res, err := c.client.Do(http.MethodPost, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// GetMaxCollateralRedeem
+// Retrieve the maximum amount of collateral that can be redeemed.
+//
+// https://www.okx.com/docs-v5/en/#financial-product-flexible-loan-get-maximum-redeemable-amount
+func (c *Loan) GetMaxCollateralRedeem(req requests.MaxCollateralRedeem) (response responses.MaxCollateralRedeem, err error) {
+	p := "/api/v5/finance/flexible-loan/max-collateral-redeem-amount"
+	res, err := c.client.Do(http.MethodGet, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// AdjustCollateral
+// Add or reduce the collateral of the flexible loan.
+//
+// https://www.okx.com/docs-v5/en/#financial-product-flexible-loan-post-adjust-collateral
+func (c *Loan) AdjustCollateral(req requests.AdjustCollateral) (response responses.AdjustCollateral, err error) {
+	p := "/api/v5/finance/flexible-loan/adjust-collateral"
+	res, err := c.client.Do(http.MethodPost, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// GetLoanInfo
+// Retrieve the current flexible loan with its collateral and LTV.
+//
+// https://www.okx.com/docs-v5/en/#financial-product-flexible-loan-get-loan-info
+func (c *Loan) GetLoanInfo() (response responses.LoanInfo, err error) {
+	p := "/api/v5/finance/flexible-loan/loan-info"
+	res, err := c.client.Do(http.MethodGet, p, true)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// GetLoanHistory
+// Retrieve the flexible loan history.
+//
+// https://www.okx.com/docs-v5/en/#financial-product-flexible-loan-get-loan-history
+func (c *Loan) GetLoanHistory(req requests.LoanHistory) (response responses.LoanHistory, err error) {
+	p := "/api/v5/finance/flexible-loan/loan-history"
+	res, err := c.client.Do(http.MethodGet, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// GetInterestAccrued
+// Retrieve the interests accrued by the flexible loan.
+//
+// https://www.okx.com/docs-v5/en/#financial-product-flexible-loan-get-accrued-interest
+func (c *Loan) GetInterestAccrued(req requests.InterestAccrued) (response responses.InterestAccrued, err error) {
+	p := "/api/v5/finance/flexible-loan/interest-accrued"
+	res, err := c.client.Do(http.MethodGet, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// VIPBorrowRepay
+// Borrow or repay a VIP loan.
+//
+// https://www.okx.com/docs-v5/en/#trading-account-rest-api-vip-loans-borrow-and-repay
+func (c *Loan) VIPBorrowRepay(req requests.VIPBorrowRepay) (response responses.VIPBorrowRepay, err error) {
+	p := "/api/v5/account/borrow-repay"
+	res, err := c.client.Do(http.MethodPost, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// GetVIPInterestAccrued
+// Retrieve the interests accrued by the VIP loans.
+//
+// https://www.okx.com/docs-v5/en/#trading-account-rest-api-get-vip-interest-accrued-data
+func (c *Loan) GetVIPInterestAccrued(req requests.VIPInterest) (response responses.InterestAccrued, err error) {
+	p := "/api/v5/account/vip-interest-accrued"
+	res, err := c.client.Do(http.MethodGet, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// GetVIPInterestDeducted
+// Retrieve the interests deducted for the VIP loans.
+//
+// https://www.okx.com/docs-v5/en/#trading-account-rest-api-get-vip-interest-deducted-data
+func (c *Loan) GetVIPInterestDeducted(req requests.VIPInterest) (response responses.InterestAccrued, err error) {
+	p := "/api/v5/account/vip-interest-deducted"
+	res, err := c.client.Do(http.MethodGet, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// GetVIPLoanOrders
+// Retrieve the VIP loan orders.
+//
+// https://www.okx.com/docs-v5/en/#trading-account-rest-api-get-vip-loan-order-list
+func (c *Loan) GetVIPLoanOrders(req requests.VIPLoanOrders) (response responses.VIPLoanOrders, err error) {
+	p := "/api/v5/account/vip-loan-order-list"
+	res, err := c.client.Do(http.MethodGet, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// GetVIPLoanOrderDetail
+// Retrieve the borrowings, repayments and rate changes of a VIP loan order.
+//
+// https://www.okx.com/docs-v5/en/#trading-account-rest-api-get-vip-loan-order-detail
+func (c *Loan) GetVIPLoanOrderDetail(req requests.VIPLoanOrderDetail) (response responses.VIPLoanOrderDetail, err error) {
+	p := "/api/v5/account/vip-loan-order-detail"
+	res, err := c.client.Do(http.MethodGet, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
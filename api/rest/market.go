@@ -2,7 +2,6 @@ package rest
 
 import (
 	"encoding/json"
-	"github.com/liuhengloveyou/okx-go"
 	requests "github.com/liuhengloveyou/okx-go/requests/rest/market"
 	responses "github.com/liuhengloveyou/okx-go/responses/market"
 	"net/http"
@@ -26,8 +25,7 @@ func NewMarket(c *ClientRest) *Market {
 // https://www.okx.com/docs-v5/en/#rest-api-market-data-get-tickers
 func (c *Market) GetTickers(req requests.GetTickers) (response responses.Ticker, err error) {
 	p := "/api/v5/market/tickers"
-	m := okx.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, false, m)
+	res, err := c.client.Do(http.MethodGet, p, false, req)
 	if err != nil {
 		return
 	}
@@ -43,8 +41,7 @@ func (c *Market) GetTickers(req requests.GetTickers) (response responses.Ticker,
 // https://www.okx.com/docs-v5/en/#rest-api-market-data-get-ticker
 func (c *Market) GetTicker(req requests.GetTicker) (response responses.Ticker, err error) {
 	p := "/api/v5/market/ticker"
-	m := okx.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, false, m)
+	res, err := c.client.Do(http.MethodGet, p, false, req)
 	if err != nil {
 		return
 	}
@@ -60,8 +57,7 @@ func (c *Market) GetTicker(req requests.GetTicker) (response responses.Ticker, e
 // https://www.okx.com/docs-v5/en/#rest-api-market-data-get-index-tickers
 func (c *Market) GetIndexTickers(req requests.GetIndexTickers) (response responses.Ticker, err error) {
 	p := "/api/v5/market/ticker"
-	m := okx.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, false, m)
+	res, err := c.client.Do(http.MethodGet, p, false, req)
 	if err != nil {
 		return
 	}
@@ -77,8 +73,7 @@ func (c *Market) GetIndexTickers(req requests.GetIndexTickers) (response respons
 // https://www.okx.com/docs-v5/en/#rest-api-market-data-get-order-book
 func (c *Market) GetOrderBook(req requests.GetOrderBook) (response responses.OrderBook, err error) {
 	p := "/api/v5/market/books"
-	m := okx.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, false, m)
+	res, err := c.client.Do(http.MethodGet, p, false, req)
 	if err != nil {
 		return
 	}
@@ -94,8 +89,7 @@ func (c *Market) GetOrderBook(req requests.GetOrderBook) (response responses.Ord
 // https://www.okx.com/docs-v5/en/#rest-api-market-data-get-candlesticks
 func (c *Market) Candlesticks(req requests.Candlesticks) (response responses.Candlesticks, err error) {
 	p := "/api/v5/market/candles"
-	m := okx.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, false, m)
+	res, err := c.client.Do(http.MethodGet, p, false, req)
 	if err != nil {
 		return
 	}
@@ -111,8 +105,7 @@ func (c *Market) Candlesticks(req requests.Candlesticks) (response responses.Can
 // https://www.okx.com/docs-v5/en/#rest-api-market-data-get-candlesticks
 func (c *Market) CandlesticksHistory(req requests.Candlesticks) (response responses.Candlesticks, err error) {
 	p := "/api/v5/market/history-candles"
-	m := okx.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, false, m)
+	res, err := c.client.Do(http.MethodGet, p, false, req)
 	if err != nil {
 		return
 	}
@@ -128,8 +121,7 @@ func (c *Market) CandlesticksHistory(req requests.Candlesticks) (response respon
 // https://www.okx.com/docs-v5/en/#rest-api-market-data-get-index-candlesticks
 func (c *Market) GetIndexCandlesticks(req requests.GetCandlesticks) (response responses.IndexCandle, err error) {
 	p := "/api/v5/market/index-candles"
-	m := okx.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, false, m)
+	res, err := c.client.Do(http.MethodGet, p, false, req)
 	if err != nil {
 		return
 	}
@@ -145,8 +137,7 @@ func (c *Market) GetIndexCandlesticks(req requests.GetCandlesticks) (response re
 // https://www.okx.com/docs-v5/en/#rest-api-market-data-get-mark-price-candlesticks
 func (c *Market) GetMarkPriceCandlesticks(req requests.GetCandlesticks) (response responses.CandleMarket, err error) {
 	p := "/api/v5/market/mark-price-candles"
-	m := okx.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, false, m)
+	res, err := c.client.Do(http.MethodGet, p, false, req)
 	if err != nil {
 		return
 	}
@@ -162,8 +153,7 @@ func (c *Market) GetMarkPriceCandlesticks(req requests.GetCandlesticks) (respons
 // https://www.okx.com/docs-v5/en/#rest-api-market-data-get-trades
 func (c *Market) GetTrades(req requests.GetTrades) (response responses.Trade, err error) {
 	p := "/api/v5/market/trades"
-	m := okx.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, false, m)
+	res, err := c.client.Do(http.MethodGet, p, false, req)
 	if err != nil {
 		return
 	}
@@ -195,8 +185,7 @@ func (c *Market) Get24HTotalVolume() (response responses.TotalVolume24H, err err
 // https://www.okx.com/docs-v5/en/#rest-api-market-data-get-index-components
 func (c *Market) GetIndexComponents(req requests.GetIndexComponents) (response responses.IndexComponent, err error) {
 	p := "/api/v5/market/index-components"
-	m := okx.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, false, m)
+	res, err := c.client.Do(http.MethodGet, p, false, req)
 	if err != nil {
 		return
 	}
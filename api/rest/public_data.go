@@ -2,7 +2,6 @@ package rest
 
 import (
 	"encoding/json"
-	"github.com/liuhengloveyou/okx-go"
 	requests "github.com/liuhengloveyou/okx-go/requests/rest/public"
 	responses "github.com/liuhengloveyou/okx-go/responses/public_data"
 	"net/http"
@@ -26,8 +25,7 @@ func NewPublicData(c *ClientRest) *PublicData {
 // https://www.okx.com/docs-v5/en/#rest-api-public-data-get-instruments
 func (c *PublicData) GetInstruments(req requests.GetInstruments) (response responses.GetInstruments, err error) {
 	p := "/api/v5/public/instruments"
-	m := okx.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, false, m)
+	res, err := c.client.Do(http.MethodGet, p, false, req)
 	if err != nil {
 		return
 	}
@@ -43,8 +41,7 @@ func (c *PublicData) GetInstruments(req requests.GetInstruments) (response respo
 // https://www.okx.com/docs-v5/en/#rest-api-public-data-get-instruments
 func (c *PublicData) GetDeliveryExerciseHistory(req requests.GetDeliveryExerciseHistory) (response responses.GetDeliveryExerciseHistory, err error) {
 	p := "/api/v5/public/delivery-exercise-history"
-	m := okx.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, false, m)
+	res, err := c.client.Do(http.MethodGet, p, false, req)
 	if err != nil {
 		return
 	}
@@ -60,8 +57,7 @@ func (c *PublicData) GetDeliveryExerciseHistory(req requests.GetDeliveryExercise
 // https://www.okx.com/docs-v5/en/#rest-api-public-data-get-open-interest
 func (c *PublicData) GetOpenInterest(req requests.GetOpenInterest) (response responses.GetOpenInterest, err error) {
 	p := "/api/v5/public/open-interest"
-	m := okx.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, false, m)
+	res, err := c.client.Do(http.MethodGet, p, false, req)
 	if err != nil {
 		return
 	}
@@ -77,8 +73,7 @@ func (c *PublicData) GetOpenInterest(req requests.GetOpenInterest) (response res
 // https://www.okx.com/docs-v5/en/#rest-api-public-data-get-limit-price
 func (c *PublicData) GetLimitPrice(req requests.GetLimitPrice) (response responses.GetLimitPrice, err error) {
 	p := "/api/v5/public/price-limit"
-	m := okx.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, false, m)
+	res, err := c.client.Do(http.MethodGet, p, false, req)
 	if err != nil {
 		return
 	}
@@ -94,8 +89,7 @@ func (c *PublicData) GetLimitPrice(req requests.GetLimitPrice) (response respons
 // https://www.okx.com/docs-v5/en/#rest-api-public-data-get-option-market-data
 func (c *PublicData) GetOptionMarketData(req requests.GetOptionMarketData) (response responses.GetOptionMarketData, err error) {
 	p := "/api/v5/public/opt-summary"
-	m := okx.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, false, m)
+	res, err := c.client.Do(http.MethodGet, p, false, req)
 	if err != nil {
 		return
 	}
@@ -111,8 +105,7 @@ func (c *PublicData) GetOptionMarketData(req requests.GetOptionMarketData) (resp
 // https://www.okx.com/docs-v5/en/#rest-api-public-data-get-estimated-delivery-Exercise-price
 func (c *PublicData) GetEstimatedDeliveryExercisePrice(req requests.GetEstimatedDeliveryExercisePrice) (response responses.GetEstimatedDeliveryExercisePrice, err error) {
 	p := "/api/v5/public/estimated-price"
-	m := okx.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, false, m)
+	res, err := c.client.Do(http.MethodGet, p, false, req)
 	if err != nil {
 		return
 	}
@@ -128,8 +121,7 @@ func (c *PublicData) GetEstimatedDeliveryExercisePrice(req requests.GetEstimated
 // https://www.okx.com/docs-v5/en/#rest-api-public-data-get-discount-rate-and-interest-free-quota
 func (c *PublicData) GetDiscountRateAndInterestFreeQuota(req requests.GetDiscountRateAndInterestFreeQuota) (response responses.GetDiscountRateAndInterestFreeQuota, err error) {
 	p := "/api/v5/public/discount-rate-interest-free-quota"
-	m := okx.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, false, m)
+	res, err := c.client.Do(http.MethodGet, p, false, req)
 	if err != nil {
 		return
 	}
@@ -161,8 +153,7 @@ func (c *PublicData) GetSystemTime() (response responses.GetSystemTime, err erro
 // https://www.okx.com/docs-v5/en/#rest-api-public-data-get-liquidation-orders
 func (c *PublicData) GetLiquidationOrders(req requests.GetLiquidationOrders) (response responses.GetLiquidationOrders, err error) {
 	p := "/api/v5/public/liquidation-orders"
-	m := okx.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, false, m)
+	res, err := c.client.Do(http.MethodGet, p, false, req)
 	if err != nil {
 		return
 	}
@@ -180,8 +171,7 @@ func (c *PublicData) GetLiquidationOrders(req requests.GetLiquidationOrders) (re
 // https://www.okx.com/docs-v5/en/#rest-api-public-data-get-mark-price
 func (c *PublicData) GetMarkPrice(req requests.GetMarkPrice) (response responses.GetMarkPrice, err error) {
 	p := "/api/v5/public/mark-price"
-	m := okx.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, false, m)
+	res, err := c.client.Do(http.MethodGet, p, false, req)
 	if err != nil {
 		return
 	}
@@ -197,8 +187,7 @@ func (c *PublicData) GetMarkPrice(req requests.GetMarkPrice) (response responses
 // https://www.okx.com/docs-v5/en/#rest-api-public-data-get-position-tiers
 func (c *PublicData) GetPositionTiers(req requests.GetPositionTiers) (response responses.GetPositionTiers, err error) {
 	p := "/api/v5/public/position-tiers"
-	m := okx.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, false, m)
+	res, err := c.client.Do(http.MethodGet, p, false, req)
 	if err != nil {
 		return
 	}
@@ -229,8 +218,7 @@ func (c *PublicData) GetInterestRateAndLoanQuota() (response responses.GetIntere
 // https://www.okx.com/docs-v5/en/#rest-api-public-data-get-underlying
 func (c *PublicData) GetUnderlying(req requests.GetUnderlying) (response responses.GetUnderlying, err error) {
 	p := "/api/v5/public/underlying"
-	m := okx.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, false, m)
+	res, err := c.client.Do(http.MethodGet, p, false, req)
 	if err != nil {
 		return
 	}
@@ -245,8 +233,7 @@ func (c *PublicData) GetUnderlying(req requests.GetUnderlying) (response respons
 // https://www.okx.com/docs-v5/en/#public-data-rest-api-unit-convert
 func (c *PublicData) ConvertUnit(req requests.UnitConvert) (response responses.UnitConvert, err error) {
 	p := "/api/v5/public/convert-contract-coin"
-	m := okx.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, false, m)
+	res, err := c.client.Do(http.MethodGet, p, false, req)
 	if err != nil {
 		return
 	}
@@ -257,12 +244,28 @@ func (c *PublicData) ConvertUnit(req requests.UnitConvert) (response responses.U
 }
 
 // GetFundingRate
+// Retrieve the funding rate of a perpetual swap, or of all of them when InstID is ANY.
 //
 // https://www.okx.com/docs-v5/zh/#public-data-rest-api-get-funding-rate
 func (c *PublicData) GetFundingRate(req requests.GetFundingRate) (response responses.GetFundingRate, err error) {
 	p := "/api/v5/public/funding-rate"
-	m := okx.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, false, m)
+	res, err := c.client.Do(http.MethodGet, p, false, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+	return
+}
+
+// GetFundingRateHistory
+// Retrieve the funding rate history of a perpetual swap, up to the last 3 months, most recent first.
+//
+// https://www.okx.com/docs-v5/en/#public-data-rest-api-get-funding-rate-history
+func (c *PublicData) GetFundingRateHistory(req requests.GetFundingRateHistory) (response responses.GetFundingRateHistory, err error) {
+	p := "/api/v5/public/funding-rate-history"
+	res, err := c.client.Do(http.MethodGet, p, false, req)
 	if err != nil {
 		return
 	}
@@ -0,0 +1,251 @@
+package rest
+
+import (
+	"encoding/json"
+	"net/http"
+
+	requests "github.com/liuhengloveyou/okx-go/requests/rest/spread"
+	responses "github.com/liuhengloveyou/okx-go/responses/spread"
+	"github.com/liuhengloveyou/okx-go/risk"
+)
+
+// Spread
+//
+// https://www.okx.com/docs-v5/en/#spread-trading-rest-api
+type Spread struct {
+	client *ClientRest
+}
+
+// NewSpread returns a pointer to a fresh Spread
+func NewSpread(c *ClientRest) *Spread {
+	return &Spread{c}
+}
+
+// PlaceOrder
+// Place a new order on a spread, stamped with the broker tag and ClOrdID of the client like the orders of Trade.
+//
+// https://www.okx.com/docs-v5/en/#spread-trading-rest-api-place-order
+func (c *Spread) PlaceOrder(req requests.PlaceOrder) (response responses.PlaceOrder, err error) {
+	p := "/api/v5/sprd/order"
+	if req.Tag == "" {
+		req.Tag = c.client.brokerTag
+	}
+	if req.ClOrdID == "" && c.client.clOrdID != nil {
+		req.ClOrdID = c.client.clOrdID()
+	}
+	o := risk.Order{InstID: req.SprdID, ClOrdID: req.ClOrdID, Side: req.Side, Sz: req.Sz, Px: req.Px}
+	if c.client.risk != nil {
+		if err = c.client.risk.Check(o); err != nil {
+			return
+		}
+	}
+	res, err := c.client.Do(http.MethodPost, p, true, req)
+	if err != nil {
+		c.client.release(err, o)
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// CancelOrder
+// Cancel an incomplete spread order.
+//
+// https://www.okx.com/docs-v5/en/#spread-trading-rest-api-cancel-order
+func (c *Spread) CancelOrder(req requests.CancelOrder) (response responses.CancelOrder, err error) {
+	p := "/api/v5/sprd/cancel-order"
+	res, err := c.client.Do(http.MethodPost, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// MassCancel
+// Cancel all the pending spread orders, or the ones of a spread when SprdID is set.
+//
+// https://www.okx.com/docs-v5/en/#spread-trading-rest-api-cancel-all-orders
+func (c *Spread) MassCancel(req requests.MassCancel) (response responses.MassCancel, err error) {
+	p := "/api/v5/sprd/mass-cancel"
+	res, err := c.client.Do(http.MethodPost, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// AmendOrder
+// Amend an incomplete spread order.
+//
+// Only the kill switch of the risk gate applies, the request doesn't name the spread of the order.
+//
+// https://www.okx.com/docs-v5/en/#spread-trading-rest-api-amend-order
+func (c *Spread) AmendOrder(req requests.AmendOrder) (response responses.AmendOrder, err error) {
+	p := "/api/v5/sprd/amend-order"
+	if c.client.risk != nil {
+		if err = c.client.risk.CheckKill(""); err != nil {
+			return
+		}
+	}
+	res, err := c.client.Do(http.MethodPost, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// GetOrderDetail
+// Retrieve the details of a spread order.
+//
+// https://www.okx.com/docs-v5/en/#spread-trading-rest-api-get-order-details
+func (c *Spread) GetOrderDetail(req requests.OrderDetails) (response responses.OrderList, err error) {
+	p := "/api/v5/sprd/order"
+	res, err := c.client.Do(http.MethodGet, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// GetOrderList
+// Retrieve all incomplete spread orders under the current account.
+//
+// https://www.okx.com/docs-v5/en/#spread-trading-rest-api-get-active-orders
+func (c *Spread) GetOrderList(req requests.OrderList) (response responses.OrderList, err error) {
+	p := "/api/v5/sprd/orders-pending"
+	res, err := c.client.Do(http.MethodGet, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// GetOrderHistory
+// Retrieve the completed spread orders of the last 21 days, or 3 months with archive.
+//
+// https://www.okx.com/docs-v5/en/#spread-trading-rest-api-get-orders-last-21-days
+//
+// https://www.okx.com/docs-v5/en/#spread-trading-rest-api-get-orders-history-last-3-months
+func (c *Spread) GetOrderHistory(req requests.OrderList, archive bool) (response responses.OrderList, err error) {
+	p := "/api/v5/sprd/orders-history"
+	if archive {
+		p = "/api/v5/sprd/orders-history-archive"
+	}
+	res, err := c.client.Do(http.MethodGet, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// GetTrades
+// Retrieve the spread trades of the last 7 days, with the fills of each leg.
+//
+// https://www.okx.com/docs-v5/en/#spread-trading-rest-api-get-trades-last-7-days
+func (c *Spread) GetTrades(req requests.Trades) (response responses.Trades, err error) {
+	p := "/api/v5/sprd/trades"
+	res, err := c.client.Do(http.MethodGet, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// GetSpreads
+// Retrieve all the spreads available for trading.
+//
+// https://www.okx.com/docs-v5/en/#spread-trading-rest-api-get-spreads-public
+func (c *Spread) GetSpreads(req requests.Spreads) (response responses.Spreads, err error) {
+	p := "/api/v5/sprd/spreads"
+	res, err := c.client.Do(http.MethodGet, p, false, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// GetOrderBook
+// Retrieve the order book of a spread.
+//
+// https://www.okx.com/docs-v5/en/#spread-trading-rest-api-get-order-book-public
+func (c *Spread) GetOrderBook(req requests.OrderBook) (response responses.OrderBook, err error) {
+	p := "/api/v5/sprd/books"
+	res, err := c.client.Do(http.MethodGet, p, false, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// GetTicker
+// Retrieve the latest price snapshot, best bid/ask price and quantity of a spread.
+//
+// https://www.okx.com/docs-v5/en/#spread-trading-rest-api-get-ticker-public
+func (c *Spread) GetTicker(req requests.Ticker) (response responses.Ticker, err error) {
+	p := "/api/v5/market/sprd-ticker"
+	res, err := c.client.Do(http.MethodGet, p, false, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// GetPublicTrades
+// Retrieve the recent trades of a spread, or of all spreads.
+//
+// https://www.okx.com/docs-v5/en/#spread-trading-rest-api-get-public-trades-public
+func (c *Spread) GetPublicTrades(req requests.PublicTrades) (response responses.PublicTrades, err error) {
+	p := "/api/v5/sprd/public-trades"
+	res, err := c.client.Do(http.MethodGet, p, false, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
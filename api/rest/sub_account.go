@@ -2,11 +2,9 @@ package rest
 
 import (
 	"encoding/json"
-	"github.com/liuhengloveyou/okx-go"
 	requests "github.com/liuhengloveyou/okx-go/requests/rest/subaccount"
 	responses "github.com/liuhengloveyou/okx-go/responses/sub_account"
 	"net/http"
-	"strings"
 )
 
 // SubAccount
@@ -27,8 +25,7 @@ func NewSubAccount(c *ClientRest) *SubAccount {
 // https://www.okx.com/docs-v5/en/#sub-account-rest-api-get-sub-account-list
 func (c *SubAccount) ViewList(req requests.ViewList) (response responses.ViewList, err error) {
 	p := "/api/v5/users/subaccount/list"
-	m := okx.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, true, m)
+	res, err := c.client.Do(http.MethodGet, p, true, req)
 	if err != nil {
 		return
 	}
@@ -44,11 +41,7 @@ func (c *SubAccount) ViewList(req requests.ViewList) (response responses.ViewLis
 // https://www.okx.com/docs-v5/en/#rest-api-subaccount-create-an-apikey-for-a-sub-account
 func (c *SubAccount) CreateAPIKey(req requests.CreateAPIKey) (response responses.APIKey, err error) {
 	p := "/api/v5/users/subaccount/apikey"
-	m := okx.S2M(req)
-	if len(req.IP) > 0 {
-		m["ip"] = strings.Join(req.IP, ",")
-	}
-	res, err := c.client.Do(http.MethodPost, p, true, m)
+	res, err := c.client.Do(http.MethodPost, p, true, req)
 	if err != nil {
 		return
 	}
@@ -64,8 +57,7 @@ func (c *SubAccount) CreateAPIKey(req requests.CreateAPIKey) (response responses
 // https://www.okx.com/docs-v5/broker_en/#non-disclosed-broker-api-query-the-api-key-of-a-sub-account
 func (c *SubAccount) QueryAPIKey(req requests.QueryAPIKey) (response responses.QueryAPIKey, err error) {
 	p := "/api/v5/broker/nd/subaccount/apikey"
-	m := okx.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, true, m)
+	res, err := c.client.Do(http.MethodGet, p, true, req)
 	if err != nil {
 		return
 	}
@@ -80,11 +72,7 @@ func (c *SubAccount) QueryAPIKey(req requests.QueryAPIKey) (response responses.Q
 // https://www.okx.com/docs-v5/broker_en/#non-disclosed-broker-api-reset-the-api-key-of-a-sub-account
 func (c *SubAccount) ResetAPIKey(req requests.ResetAPIKey) (response responses.ResetAPIKey, err error) {
 	p := "/api/v5/broker/nd/subaccount/modify-apikey"
-	m := okx.S2M(req)
-	if len(req.IP) > 0 {
-		m["ip"] = strings.Join(req.IP, ",")
-	}
-	res, err := c.client.Do(http.MethodPost, p, true, m)
+	res, err := c.client.Do(http.MethodPost, p, true, req)
 	if err != nil {
 		return
 	}
@@ -100,8 +88,7 @@ func (c *SubAccount) ResetAPIKey(req requests.ResetAPIKey) (response responses.R
 // https://www.okx.com/docs-v5/en/#rest-api-subaccount-delete-the-apikey-of-sub-accounts
 func (c *SubAccount) DeleteAPIKey(req requests.DeleteAPIKey) (response responses.APIKey, err error) {
 	p := "/api/v5/users/subaccount/delete-apikey"
-	m := okx.S2M(req)
-	res, err := c.client.Do(http.MethodPost, p, true, m)
+	res, err := c.client.Do(http.MethodPost, p, true, req)
 	if err != nil {
 		return
 	}
@@ -118,8 +105,7 @@ func (c *SubAccount) DeleteAPIKey(req requests.DeleteAPIKey) (response responses
 // https://www.okx.com/docs-v5/en/#rest-api-subaccount-get-sub-account-balance
 func (c *SubAccount) GetBalance(req requests.GetBalance) (response responses.GetBalance, err error) {
 	p := "/api/v5/account/subaccount/balances"
-	m := okx.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, true, m)
+	res, err := c.client.Do(http.MethodGet, p, true, req)
 	if err != nil {
 		return
 	}
@@ -135,8 +121,7 @@ func (c *SubAccount) GetBalance(req requests.GetBalance) (response responses.Get
 // https://www.okx.com/docs-v5/en/#sub-account-rest-api-get-sub-account-funding-balance
 func (c *SubAccount) GetBalancesFunding(req requests.GetBalancesFunding) (response responses.GetBalancesFunding, err error) {
 	p := "/api/v5/asset/subaccount/balances"
-	m := okx.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, true, m)
+	res, err := c.client.Do(http.MethodGet, p, true, req)
 	if err != nil {
 		return
 	}
@@ -152,8 +137,7 @@ func (c *SubAccount) GetBalancesFunding(req requests.GetBalancesFunding) (respon
 // https://www.okx.com/docs-v5/en/#rest-api-subaccount-history-of-sub-account-transfer
 func (c *SubAccount) HistoryTransfer(req requests.HistoryTransfer) (response responses.HistoryTransfer, err error) {
 	p := "/api/v5/account/subaccount/bills"
-	m := okx.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, true, m)
+	res, err := c.client.Do(http.MethodGet, p, true, req)
 	if err != nil {
 		return
 	}
@@ -170,8 +154,7 @@ func (c *SubAccount) HistoryTransfer(req requests.HistoryTransfer) (response res
 // https://www.okx.com/docs-v5/en/#sub-account-rest-api-master-accounts-manage-the-transfers-between-sub-accounts
 func (c *SubAccount) ManageTransfers(req requests.ManageTransfers) (response responses.ManageTransfer, err error) {
 	p := "/api/v5/asset/subaccount/transfer"
-	m := okx.S2M(req)
-	res, err := c.client.Do(http.MethodPost, p, true, m)
+	res, err := c.client.Do(http.MethodPost, p, true, req)
 	if err != nil {
 		return
 	}
@@ -187,8 +170,7 @@ func (c *SubAccount) ManageTransfers(req requests.ManageTransfers) (response res
 // https://www.okx.com/docs-v5/broker_en/#non-disclosed-broker-api-get-sub-account-list
 func (c *SubAccount) ListSubAccount(req requests.ListSubAccount) (response responses.ListSubAccount, err error) {
 	p := "/api/v5/broker/nd/subaccount-info"
-	m := okx.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, true, m)
+	res, err := c.client.Do(http.MethodGet, p, true, req)
 	if err != nil {
 		return
 	}
@@ -204,8 +186,7 @@ func (c *SubAccount) ListSubAccount(req requests.ListSubAccount) (response respo
 // https://www.okx.com/docs-v5/broker_en/#non-disclosed-broker-api-create-sub-account
 func (c *SubAccount) CreateSubAccount(req requests.CreateSubAccount) (response responses.CreateSubAccount, err error) {
 	p := "/api/v5/broker/nd/create-subaccount"
-	m := okx.S2M(req)
-	res, err := c.client.Do(http.MethodPost, p, true, m)
+	res, err := c.client.Do(http.MethodPost, p, true, req)
 	if err != nil {
 		return
 	}
@@ -221,8 +202,7 @@ func (c *SubAccount) CreateSubAccount(req requests.CreateSubAccount) (response r
 // https://www.okx.com/docs-v5/broker_en/#non-disclosed-broker-api-delete-sub-account
 func (c *SubAccount) DeleteSubAccount(req requests.DeleteSubAccount) (response responses.DeleteSubAccount, err error) {
 	p := "/api/v5/broker/nd/delete-subaccount"
-	m := okx.S2M(req)
-	res, err := c.client.Do(http.MethodPost, p, true, m)
+	res, err := c.client.Do(http.MethodPost, p, true, req)
 	if err != nil {
 		return
 	}
@@ -239,11 +219,7 @@ func (c *SubAccount) DeleteSubAccount(req requests.DeleteSubAccount) (response r
 func (c *SubAccount) CreateAPIKeySubAccount(req requests.CreatAPIKeySubAccount) (
 	response responses.CreatAPIKeySubAccount, err error) {
 	p := "/api/v5/broker/nd/subaccount/apikey"
-	m := okx.S2M(req)
-	if len(req.IP) > 0 {
-		m["ip"] = strings.Join(req.IP, ",")
-	}
-	res, err := c.client.Do(http.MethodPost, p, true, m)
+	res, err := c.client.Do(http.MethodPost, p, true, req)
 	if err != nil {
 		return
 	}
@@ -260,8 +236,7 @@ func (c *SubAccount) CreateAPIKeySubAccount(req requests.CreatAPIKeySubAccount)
 func (c *SubAccount) UpdateAPIKeySubAccount(req requests.UpdateAPIKEySubAccount) (
 	response responses.UpdateAPIKEySubAccount, err error) {
 	p := "/api/v5/broker/nd/subaccount/modify-apikey"
-	m := okx.S2M(req)
-	res, err := c.client.Do(http.MethodPost, p, true, m)
+	res, err := c.client.Do(http.MethodPost, p, true, req)
 	if err != nil {
 		return
 	}
@@ -278,8 +253,7 @@ func (c *SubAccount) UpdateAPIKeySubAccount(req requests.UpdateAPIKEySubAccount)
 func (c *SubAccount) DeleteAPIKEySubAccount(req requests.DeleteAPIKeySubAccount) (
 	response responses.DeleteAPIKeySubAccount, err error) {
 	p := "/api/v5/broker/nd/subaccount/delete-apikey"
-	m := okx.S2M(req)
-	res, err := c.client.Do(http.MethodPost, p, true, m)
+	res, err := c.client.Do(http.MethodPost, p, true, req)
 	if err != nil {
 		return
 	}
@@ -296,8 +270,7 @@ func (c *SubAccount) DeleteAPIKEySubAccount(req requests.DeleteAPIKeySubAccount)
 func (c *SubAccount) SetLevelSubAccount(req requests.SetLevelSubAccount) (response responses.SetLevelSubAccount,
 	err error) {
 	p := "/api/v5/broker/nd/set-subaccount-level"
-	m := okx.S2M(req)
-	res, err := c.client.Do(http.MethodPost, p, true, m)
+	res, err := c.client.Do(http.MethodPost, p, true, req)
 	if err != nil {
 		return
 	}
@@ -313,8 +286,7 @@ func (c *SubAccount) SetLevelSubAccount(req requests.SetLevelSubAccount) (respon
 func (c *SubAccount) GetFeeRatesSubAccount(req requests.GetFeeRatesSubAccount) (response responses.GetFeeRatesSubAccount,
 	err error) {
 	p := "/api/v5/account/trade-fee"
-	m := okx.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, true, m)
+	res, err := c.client.Do(http.MethodGet, p, true, req)
 	if err != nil {
 		return
 	}
@@ -331,8 +303,7 @@ func (c *SubAccount) GetFeeRatesSubAccount(req requests.GetFeeRatesSubAccount) (
 func (c *SubAccount) SetFeeRateSubAccount(req requests.SetFeeRateSubAccount) (response responses.SetFeeRateSubAccount,
 	err error) {
 	p := "/api/v5/broker/nd/set-subaccount-fee-rate"
-	m := okx.S2M(req)
-	res, err := c.client.Do(http.MethodPost, p, true, m)
+	res, err := c.client.Do(http.MethodPost, p, true, req)
 	if err != nil {
 		return
 	}
@@ -349,8 +320,7 @@ func (c *SubAccount) SetFeeRateSubAccount(req requests.SetFeeRateSubAccount) (re
 func (c *SubAccount) CreateDepositAddressSubAccount(req requests.CreateDepositAddress) (
 	response responses.CreateDepositAddress, err error) {
 	p := "/api/v5/asset/broker/nd/subaccount-deposit-address"
-	m := okx.S2M(req)
-	res, err := c.client.Do(http.MethodPost, p, true, m)
+	res, err := c.client.Do(http.MethodPost, p, true, req)
 	if err != nil {
 		return
 	}
@@ -367,8 +337,7 @@ func (c *SubAccount) CreateDepositAddressSubAccount(req requests.CreateDepositAd
 func (c *SubAccount) UpdateDepositAddressSubAccount(req requests.UpdateDepositAddress) (
 	response responses.UpdateDepositAddress, err error) {
 	p := "/api/v5/asset/broker/nd/modify-subaccount-deposit-address"
-	m := okx.S2M(req)
-	res, err := c.client.Do(http.MethodPost, p, true, m)
+	res, err := c.client.Do(http.MethodPost, p, true, req)
 	if err != nil {
 		return
 	}
@@ -385,8 +354,7 @@ func (c *SubAccount) UpdateDepositAddressSubAccount(req requests.UpdateDepositAd
 func (c *SubAccount) GetDepositAddressSubAccount(req requests.GetDepositAddress) (response responses.GetDepositAddress,
 	err error) {
 	p := "/api/v5/asset/broker/nd/subaccount-deposit-address"
-	m := okx.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, true, m)
+	res, err := c.client.Do(http.MethodGet, p, true, req)
 	if err != nil {
 		return
 	}
@@ -403,8 +371,7 @@ func (c *SubAccount) GetDepositAddressSubAccount(req requests.GetDepositAddress)
 func (c *SubAccount) GetDepositHistorySubAccount(req requests.GetDepositHistory) (response responses.GetDepositHistory,
 	err error) {
 	p := "/api/v5/asset/broker/nd/subaccount-deposit-history"
-	m := okx.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, true, m)
+	res, err := c.client.Do(http.MethodGet, p, true, req)
 	if err != nil {
 		return
 	}
@@ -421,8 +388,7 @@ func (c *SubAccount) GetDepositHistorySubAccount(req requests.GetDepositHistory)
 func (c *SubAccount) GetWithdrawHistorySubAccount(req requests.GetWithdrawHistory) (response responses.GetWithdrawHistory,
 	err error) {
 	p := "/api/v5/asset/broker/nd/subaccount-withdrawal-history"
-	m := okx.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, true, m)
+	res, err := c.client.Do(http.MethodGet, p, true, req)
 	if err != nil {
 		return
 	}
@@ -2,22 +2,42 @@ package rest
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"syscall"
+	"time"
+
 	"github.com/liuhengloveyou/okx-go"
+	models "github.com/liuhengloveyou/okx-go/models/trade"
 	requests "github.com/liuhengloveyou/okx-go/requests/rest/trade"
 	responses "github.com/liuhengloveyou/okx-go/responses/trade"
+	"github.com/liuhengloveyou/okx-go/risk"
 	"net/http"
 )
 
 // Trade
 //
+// Orders placed or amended are checked by the risk gate of the client, see SetRiskGate.
+//
 // https://www.okx.com/docs-v5/en/#rest-api-trade
 type Trade struct {
 	client *ClientRest
+	opts   []okx.RequestOption
 }
 
 // NewTrade returns a pointer to a fresh Trade
 func NewTrade(c *ClientRest) *Trade {
-	return &Trade{c}
+	return &Trade{client: c}
+}
+
+// codeOrderNotExist is the error code of OKX for an order that doesn't exist
+const codeOrderNotExist = 51603
+
+// With returns a copy of Trade applying opts to every request it sends, such as okx.WithExpiry
+func (c *Trade) With(opts ...okx.RequestOption) *Trade {
+	return &Trade{client: c.client, opts: append(append([]okx.RequestOption{}, c.opts...), opts...)}
 }
 
 // PlaceOrder
@@ -26,14 +46,63 @@ func NewTrade(c *ClientRest) *Trade {
 // https://www.okx.com/docs-v5/en/#rest-api-trade-get-positions
 func (c *Trade) PlaceOrder(req requests.PlaceOrder) (response responses.PlaceOrder, err error) {
 	p := "/api/v5/trade/order"
-	m := okx.S2M(req)
-	res, err := c.client.Do(http.MethodPost, p, true, m)
-	if err != nil {
+	req = c.stamp(req)
+	if err = c.check(req); err != nil {
 		return
 	}
-	defer res.Body.Close()
-	d := json.NewDecoder(res.Body)
-	err = d.Decode(&response)
+
+	return c.placeOrder(p, req)
+}
+
+// PlaceOrderIdempotent places the order at most once, even when the response is lost.
+//
+// The order gets a ClOrdID when it has none, from the generator of the client or else from one the client
+// creates with okx.NewClOrdIDGenerator the first time it's needed.
+// When sending fails in a way leaving unknown whether OKX got the order, such as a timeout or a dropped connection,
+// the order may have been placed anyway, so after waiting for wait it's looked up with GetOrderDetail by ClOrdID:
+// it's returned as placed when found, and sent again only when OKX reports it doesn't exist.
+// This is retried up to retries times, the last error is returned when none of them settled it.
+// Any other error, such as a rejection of the risk gate, is returned right away.
+func (c *Trade) PlaceOrderIdempotent(req requests.PlaceOrder, retries int, wait time.Duration) (response responses.PlaceOrder, err error) {
+	p := "/api/v5/trade/order"
+	req = c.stamp(req)
+	if req.ClOrdID == "" {
+		c.client.idemOnce.Do(func() {
+			c.client.idemClOrdID, c.client.idemErr = okx.NewClOrdIDGenerator("")
+		})
+		if c.client.idemErr != nil {
+			return response, c.client.idemErr
+		}
+		req.ClOrdID = c.client.idemClOrdID()
+	}
+	if err = c.check(req); err != nil {
+		return
+	}
+
+	lookup := false
+	for attempt := 0; attempt <= retries; attempt++ {
+		if lookup {
+			time.Sleep(wait)
+			detail, dErr := c.GetOrderDetail(requests.OrderDetails{InstID: req.InstID, ClOrdID: req.ClOrdID})
+			switch {
+			case dErr != nil:
+				err = dErr
+				continue
+			case detail.Code == 0 && len(detail.Orders) > 0:
+				o := detail.Orders[0]
+				return responses.PlaceOrder{PlaceOrders: []*models.PlaceOrder{{OrdID: o.OrdID, ClOrdID: o.ClOrdID, Tag: o.Tag}}}, nil
+			case detail.Code != codeOrderNotExist:
+				err = fmt.Errorf("okx: get order detail of %s failed, code %d: %s", req.ClOrdID, detail.Code, detail.Msg)
+				continue
+			}
+		}
+
+		response, err = c.placeOrder(p, req)
+		if err == nil || !unknownOutcome(err) {
+			return
+		}
+		lookup = true
+	}
 
 	return
 }
@@ -44,11 +113,24 @@ func (c *Trade) PlaceOrder(req requests.PlaceOrder) (response responses.PlaceOrd
 // https://www.okx.com/docs-v5/en/#rest-api-trade-place-multiple-orders
 func (c *Trade) PlaceMultipleOrders(req []requests.PlaceOrder) (response responses.PlaceOrder, err error) {
 	p := "/api/v5/trade/batch-order"
-	var m interface{}
-	m = req
-	res, err := c.client.DoBatch(p, m)
+	stamped := make([]requests.PlaceOrder, len(req))
+	expTimes := make([]time.Time, len(req))
+	for i, order := range req {
+		stamped[i] = c.stamp(order)
+		expTimes[i] = order.ExpTime
+	}
+	req = stamped
+	if err = c.check(req...); err != nil {
+		return
+	}
+	res, err := c.do(http.MethodPost, p, req, expTimes...)
 
 	if err != nil {
+		batch := make([]risk.Order, len(req))
+		for i, order := range req {
+			batch[i] = risk.NewOrder(order)
+		}
+		c.client.release(err, batch...)
 		return
 	}
 	defer res.Body.Close()
@@ -71,13 +153,10 @@ func (c *Trade) CancelOrder(req []requests.CancelOrder) (response responses.Canc
 	var res *http.Response
 	if len(req) > 1 {
 		p = "/api/v5/trade/cancel-batch-orders"
-		var m interface{}
-		m = req
-		res, err = c.client.DoBatch(p, m)
+		res, err = c.do(http.MethodPost, p, req)
 	} else {
 		p = "/api/v5/trade/cancel-order"
-		m := okx.S2M(req[0])
-		res, err = c.client.Do(http.MethodPost, p, true, m)
+		res, err = c.do(http.MethodPost, p, req[0])
 	}
 	if err != nil {
 		return
@@ -99,15 +178,21 @@ func (c *Trade) CancelOrder(req []requests.CancelOrder) (response responses.Canc
 func (c *Trade) AmendOrder(req []requests.AmendOrder) (response responses.AmendOrder, err error) {
 	var p string
 	var res *http.Response
+	expTimes := make([]time.Time, len(req))
+	for i, order := range req {
+		expTimes[i] = order.ExpTime
+		if c.client.risk != nil {
+			if err = c.client.risk.CheckAmend(order.InstID, order.NewPx, order.NewSz); err != nil {
+				return
+			}
+		}
+	}
 	if len(req) > 1 {
 		p = "/api/v5/trade/amend-batch-orders"
-		var m interface{}
-		m = req
-		res, err = c.client.DoBatch(p, m)
+		res, err = c.do(http.MethodPost, p, req, expTimes...)
 	} else {
 		p = "/api/v5/trade/amend-order"
-		m := okx.S2M(req[0])
-		res, err = c.client.Do(http.MethodPost, p, true, m)
+		res, err = c.do(http.MethodPost, p, req[0], expTimes...)
 	}
 	if err != nil {
 		return
@@ -124,8 +209,7 @@ func (c *Trade) AmendOrder(req []requests.AmendOrder) (response responses.AmendO
 // https://www.okx.com/docs-v5/en/#rest-api-trade-close-positions
 func (c *Trade) ClosePosition(req requests.ClosePosition) (response responses.ClosePosition, err error) {
 	p := "/api/v5/trade/close-position"
-	m := okx.S2M(req)
-	res, err := c.client.Do(http.MethodPost, p, true, m)
+	res, err := c.do(http.MethodPost, p, req)
 	if err != nil {
 		return
 	}
@@ -141,8 +225,7 @@ func (c *Trade) ClosePosition(req requests.ClosePosition) (response responses.Cl
 // https://www.okx.com/docs-v5/en/#rest-api-trade-get-order-details
 func (c *Trade) GetOrderDetail(req requests.OrderDetails) (response responses.OrderList, err error) {
 	p := "/api/v5/trade/order"
-	m := okx.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, true, m)
+	res, err := c.do(http.MethodGet, p, req)
 	if err != nil {
 		return
 	}
@@ -158,8 +241,7 @@ func (c *Trade) GetOrderDetail(req requests.OrderDetails) (response responses.Or
 // https://www.okx.com/docs-v5/en/#rest-api-trade-get-order-list
 func (c *Trade) GetOrderList(req requests.OrderList) (response responses.OrderList, err error) {
 	p := "/api/v5/trade/orders-pending"
-	m := okx.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, true, m)
+	res, err := c.do(http.MethodGet, p, req)
 	if err != nil {
 		return
 	}
@@ -181,8 +263,7 @@ func (c *Trade) GetOrderHistory(req requests.OrderList, arch bool) (response res
 	if arch {
 		p = "/api/v5/trade/orders-history-archive"
 	}
-	m := okx.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, true, m)
+	res, err := c.do(http.MethodGet, p, req)
 	if err != nil {
 		return
 	}
@@ -205,8 +286,7 @@ func (c *Trade) GetTransactionDetails(req requests.TransactionDetails, arch bool
 	if arch {
 		p = "/api/v5/trade/fills-history"
 	}
-	m := okx.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, true, m)
+	res, err := c.do(http.MethodGet, p, req)
 	if err != nil {
 		return
 	}
@@ -224,9 +304,17 @@ func (c *Trade) GetTransactionDetails(req requests.TransactionDetails, arch bool
 // https://www.okx.com/docs-v5/en/#rest-api-trade-place-algo-order
 func (c *Trade) PlaceAlgoOrder(req requests.PlaceAlgoOrder) (response responses.PlaceAlgoOrder, err error) {
 	p := "/api/v5/trade/order-algo"
-	m := okx.S2M(req)
-	res, err := c.client.Do(http.MethodPost, p, true, m)
+	if req.Tag == "" {
+		req.Tag = c.client.brokerTag
+	}
+	if c.client.risk != nil {
+		if err = c.client.risk.Check(risk.NewAlgoOrder(req)); err != nil {
+			return
+		}
+	}
+	res, err := c.do(http.MethodPost, p, req)
 	if err != nil {
+		c.client.release(err, risk.NewAlgoOrder(req))
 		return
 	}
 	defer res.Body.Close()
@@ -241,10 +329,8 @@ func (c *Trade) PlaceAlgoOrder(req requests.PlaceAlgoOrder) (response responses.
 //
 // https://www.okx.com/docs-v5/en/#rest-api-trade-cancel-algo-order
 func (c *Trade) CancelAlgoOrder(req []requests.CancelAlgoOrder) (response responses.CancelAlgoOrder, err error) {
-	var m interface{}
-	m = req
 	p := "/api/v5/trade/cancel-algos"
-	res, err := c.client.DoBatch(p, m)
+	res, err := c.do(http.MethodPost, p, req)
 	if err != nil {
 		return
 	}
@@ -262,10 +348,8 @@ func (c *Trade) CancelAlgoOrder(req []requests.CancelAlgoOrder) (response respon
 //
 // https://www.okx.com/docs-v5/en/#rest-api-trade-cancel-advance-algo-order
 func (c *Trade) CancelAdvanceAlgoOrder(req []requests.CancelAlgoOrder) (response responses.CancelAlgoOrder, err error) {
-	var m interface{}
-	m = req
 	p := "/api/v5/trade/cancel-advance-algos"
-	res, err := c.client.DoBatch(p, m)
+	res, err := c.do(http.MethodPost, p, req)
 	if err != nil {
 		return
 	}
@@ -293,8 +377,7 @@ func (c *Trade) GetAlgoOrderList(req requests.AlgoOrderList, arch bool) (respons
 	if arch {
 		p = "/api/v5/trade/orders-algo-history"
 	}
-	m := okx.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, true, m)
+	res, err := c.do(http.MethodGet, p, req)
 	if err != nil {
 		return
 	}
@@ -307,8 +390,7 @@ func (c *Trade) GetAlgoOrderList(req requests.AlgoOrderList, arch bool) (respons
 
 func (c *Trade) GetEasyConvertCurrencyList(req requests.EasyConvertCurrencyList) (response responses.EasyConvertCurrencyList, err error) {
 	p := "/api/v5/trade/easy-convert-currency-list"
-	m := okx.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, true, m)
+	res, err := c.do(http.MethodGet, p, req)
 	if err != nil {
 		return
 	}
@@ -320,13 +402,264 @@ func (c *Trade) GetEasyConvertCurrencyList(req requests.EasyConvertCurrencyList)
 
 func (c *Trade) EasyConvert(req requests.EasyConvert) (response responses.EasyConvert, err error) {
 	p := "/api/v5/trade/easy-convert"
-	res, err := c.client.DoBatch(p, req)
+	res, err := c.do(http.MethodPost, p, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+	return
+
+}
+
+// OrderPrecheck
+// Check the account information before and after placing an order, the order itself is not placed.
+//
+// https://www.okx.com/docs-v5/en/#order-book-trading-trade-post-order-precheck
+func (c *Trade) OrderPrecheck(req requests.PlaceOrder) (response responses.OrderPrecheck, err error) {
+	p := "/api/v5/trade/order-precheck"
+	res, err := c.do(http.MethodPost, p, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// MassCancel
+// Cancel all the MMP pending orders of an instrument family, only applicable to OPTION in portfolio margin mode.
+//
+// https://www.okx.com/docs-v5/en/#order-book-trading-trade-post-mass-cancel-order
+func (c *Trade) MassCancel(req requests.MassCancel) (response responses.MassCancel, err error) {
+	p := "/api/v5/trade/mass-cancel"
+	res, err := c.do(http.MethodPost, p, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// CancelAllAfter
+// Cancel all the pending orders after the countdown timeout, the dead man's switch.
+// Call it again before the timeout to postpone it, TimeOut 0 disables it.
+//
+// https://www.okx.com/docs-v5/en/#order-book-trading-trade-post-cancel-all-after
+func (c *Trade) CancelAllAfter(req requests.CancelAllAfter) (response responses.CancelAllAfter, err error) {
+	p := "/api/v5/trade/cancel-all-after"
+	res, err := c.do(http.MethodPost, p, req)
 	if err != nil {
 		return
 	}
 	defer res.Body.Close()
 	d := json.NewDecoder(res.Body)
 	err = d.Decode(&response)
+
 	return
+}
 
+// AmendAlgoOrder
+// Amend an incomplete trigger or take profit / stop loss algo order.
+//
+// https://www.okx.com/docs-v5/en/#order-book-trading-trade-post-amend-algo-order
+func (c *Trade) AmendAlgoOrder(req requests.AmendAlgoOrder) (response responses.AmendAlgoOrder, err error) {
+	p := "/api/v5/trade/amend-algos"
+	if c.client.risk != nil {
+		if err = c.client.risk.CheckAmend(req.InstID, 0, req.NewSz); err != nil {
+			return
+		}
+	}
+	res, err := c.do(http.MethodPost, p, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// GetAlgoOrderDetails
+// Retrieve an algo order by AlgoID or AlgoClOrdID.
+//
+// https://www.okx.com/docs-v5/en/#order-book-trading-trade-get-algo-order-details
+func (c *Trade) GetAlgoOrderDetails(req requests.AlgoOrderDetails) (response responses.AlgoOrderList, err error) {
+	p := "/api/v5/trade/order-algo"
+	res, err := c.do(http.MethodGet, p, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// GetOneClickRepayCurrencyList
+// Retrieve the debt currencies and the currencies they can be repaid with.
+//
+// https://www.okx.com/docs-v5/en/#order-book-trading-trade-get-one-click-repay-currency-list
+func (c *Trade) GetOneClickRepayCurrencyList(req requests.OneClickRepayCurrencyList) (response responses.OneClickRepayCurrencyList, err error) {
+	p := "/api/v5/trade/one-click-repay-currency-list"
+	res, err := c.do(http.MethodGet, p, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// OneClickRepay
+// Repay the debts of DebtCcy with RepayCcy at the market price.
+//
+// https://www.okx.com/docs-v5/en/#order-book-trading-trade-post-trade-one-click-repay
+func (c *Trade) OneClickRepay(req requests.OneClickRepay) (response responses.OneClickRepay, err error) {
+	p := "/api/v5/trade/one-click-repay"
+	res, err := c.do(http.MethodPost, p, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// GetOneClickRepayHistory
+// Retrieve the one-click repay history of the last 7 days.
+//
+// https://www.okx.com/docs-v5/en/#order-book-trading-trade-get-one-click-repay-history
+func (c *Trade) GetOneClickRepayHistory(req requests.OneClickRepayHistory) (response responses.OneClickRepay, err error) {
+	p := "/api/v5/trade/one-click-repay-history"
+	res, err := c.do(http.MethodGet, p, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// RequestFillsArchive
+// Request the generation of the transaction and order history archive of a quarter since 2022, retrieve it with GetFillsArchive once ready.
+//
+// https://www.okx.com/docs-v5/en/#order-book-trading-trade-post-apply-for-transaction-details-since-2022
+func (c *Trade) RequestFillsArchive(req requests.FillsArchive) (response responses.RequestFillsArchive, err error) {
+	p := "/api/v5/trade/fills-archive"
+	res, err := c.do(http.MethodPost, p, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// GetFillsArchive
+// Retrieve the download link of the transaction and order history archive of a quarter.
+//
+// https://www.okx.com/docs-v5/en/#order-book-trading-trade-get-transaction-details-since-2022
+func (c *Trade) GetFillsArchive(req requests.FillsArchive) (response responses.GetFillsArchive, err error) {
+	p := "/api/v5/trade/fills-archive"
+	res, err := c.do(http.MethodGet, p, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// do sends the private request with the options of Trade, the earliest of the non zero expTime, if any, takes precedence over them
+func (c *Trade) do(method, p string, req interface{}, expTime ...time.Time) (*http.Response, error) {
+	opts := c.opts
+	if t := earliest(expTime); !t.IsZero() {
+		opts = append(append([]okx.RequestOption{}, opts...), okx.WithExpTime(t))
+	}
+
+	return c.client.DoWithOptions(method, p, true, okx.NewRequestOptions(opts...), req)
+}
+
+// stamp fills the Tag and ClOrdID of the order left empty with the broker tag and the generator of the client
+func (c *Trade) stamp(order requests.PlaceOrder) requests.PlaceOrder {
+	if order.Tag == "" {
+		order.Tag = c.client.brokerTag
+	}
+	if order.ClOrdID == "" && c.client.clOrdID != nil {
+		order.ClOrdID = c.client.clOrdID()
+	}
+
+	return order
+}
+
+// placeOrder sends the order as is, once stamped and checked, it's released from the risk gate when it can't be sent
+func (c *Trade) placeOrder(p string, req requests.PlaceOrder) (response responses.PlaceOrder, err error) {
+	res, err := c.do(http.MethodPost, p, req, req.ExpTime)
+	if err != nil {
+		c.client.release(err, risk.NewOrder(req))
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// unknownOutcome reports whether err leaves unknown whether the request reached OKX:
+// a timeout, a connection dropped or reset, or a response cut short
+func unknownOutcome(err error) bool {
+	var ne net.Error
+	if errors.As(err, &ne) && ne.Timeout() {
+		return true
+	}
+
+	return errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, io.EOF) ||
+		errors.Is(err, net.ErrClosed) ||
+		errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.EPIPE)
+}
+
+// check returns the rejection of the first order refused by the risk gate of the client, if any,
+// the orders are checked as a batch
+func (c *Trade) check(orders ...requests.PlaceOrder) error {
+	if c.client.risk == nil {
+		return nil
+	}
+	batch := make([]risk.Order, len(orders))
+	for i, order := range orders {
+		batch[i] = risk.NewOrder(order)
+	}
+
+	return c.client.risk.CheckBatch(batch)
+}
+
+func earliest(ts []time.Time) (res time.Time) {
+	for _, t := range ts {
+		if !t.IsZero() && (res.IsZero() || t.Before(res)) {
+			res = t
+		}
+	}
+
+	return
 }
@@ -2,7 +2,6 @@ package rest
 
 import (
 	"encoding/json"
-	"github.com/liuhengloveyou/okx-go"
 	requests "github.com/liuhengloveyou/okx-go/requests/rest/tradedata"
 	responses "github.com/liuhengloveyou/okx-go/responses/trade_data"
 	"net/http"
@@ -42,8 +41,7 @@ func (c *TradeData) GetSupportCoin() (response responses.GetSupportCoin, err err
 // https://www.okx.com/docs-v5/en/#rest-api-trading-data-get-support-coin
 func (c *TradeData) GetTakerVolume(req requests.GetTakerVolume) (response responses.GetTakerVolume, err error) {
 	p := "/api/v5/rubik/stat/taker-volume"
-	m := okx.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, false, m)
+	res, err := c.client.Do(http.MethodGet, p, false, req)
 	if err != nil {
 		return
 	}
@@ -59,8 +57,7 @@ func (c *TradeData) GetTakerVolume(req requests.GetTakerVolume) (response respon
 // https://www.okx.com/docs-v5/en/#rest-api-trading-data-get-margin-lending-ratio
 func (c *TradeData) GetMarginLendingRatio(req requests.GetRatio) (response responses.GetRatio, err error) {
 	p := "/api/v5/rubik/stat/margin/loan-ratio"
-	m := okx.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, false, m)
+	res, err := c.client.Do(http.MethodGet, p, false, req)
 	if err != nil {
 		return
 	}
@@ -76,8 +73,7 @@ func (c *TradeData) GetMarginLendingRatio(req requests.GetRatio) (response respo
 // https://www.okx.com/docs-v5/en/#rest-api-trading-data-get-long-short-ratio
 func (c *TradeData) GetLongShortRatio(req requests.GetRatio) (response responses.GetRatio, err error) {
 	p := "/api/v5/rubik/stat/contracts/long-short-account-ratio"
-	m := okx.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, false, m)
+	res, err := c.client.Do(http.MethodGet, p, false, req)
 	if err != nil {
 		return
 	}
@@ -93,8 +89,7 @@ func (c *TradeData) GetLongShortRatio(req requests.GetRatio) (response responses
 // https://www.okx.com/docs-v5/en/#rest-api-trading-data-get-contracts-open-interest-and-volume
 func (c *TradeData) GetContractsOpenInterestAndVolume(req requests.GetRatio) (response responses.GetOpenInterestAndVolume, err error) {
 	p := "/api/v5/rubik/stat/contracts/open-interest-volume"
-	m := okx.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, false, m)
+	res, err := c.client.Do(http.MethodGet, p, false, req)
 	if err != nil {
 		return
 	}
@@ -110,8 +105,7 @@ func (c *TradeData) GetContractsOpenInterestAndVolume(req requests.GetRatio) (re
 // https://www.okx.com/docs-v5/en/#rest-api-trading-data-get-options-open-interest-and-volume
 func (c *TradeData) GetOptionsOpenInterestAndVolume(req requests.GetRatio) (response responses.GetOpenInterestAndVolume, err error) {
 	p := "/api/v5/rubik/stat/option/open-interest-volume"
-	m := okx.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, false, m)
+	res, err := c.client.Do(http.MethodGet, p, false, req)
 	if err != nil {
 		return
 	}
@@ -127,8 +121,7 @@ func (c *TradeData) GetOptionsOpenInterestAndVolume(req requests.GetRatio) (resp
 // https://www.okx.com/docs-v5/en/#rest-api-trading-data-get-put-call-ratio
 func (c *TradeData) GetPutCallRatio(req requests.GetRatio) (response responses.GetPutCallRatio, err error) {
 	p := "/api/v5/rubik/stat/option/open-interest-volume-ratio"
-	m := okx.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, false, m)
+	res, err := c.client.Do(http.MethodGet, p, false, req)
 	if err != nil {
 		return
 	}
@@ -144,8 +137,7 @@ func (c *TradeData) GetPutCallRatio(req requests.GetRatio) (response responses.G
 // https://www.okx.com/docs-v5/en/#rest-api-trading-data-get-open-interest-and-volume-expiry
 func (c *TradeData) GetOpenInterestAndVolumeExpiry(req requests.GetRatio) (response responses.GetOpenInterestAndVolumeExpiry, err error) {
 	p := "/api/v5/rubik/stat/option/open-interest-volume-expiry"
-	m := okx.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, false, m)
+	res, err := c.client.Do(http.MethodGet, p, false, req)
 	if err != nil {
 		return
 	}
@@ -161,8 +153,7 @@ func (c *TradeData) GetOpenInterestAndVolumeExpiry(req requests.GetRatio) (respo
 // https://www.okx.com/docs-v5/en/#rest-api-trading-data-get-open-interest-and-volume-strike
 func (c *TradeData) GetOpenInterestAndVolumeStrike(req requests.GetOpenInterestAndVolumeStrike) (response responses.GetOpenInterestAndVolumeStrike, err error) {
 	p := "/api/v5/rubik/stat/option/open-interest-volume-strike"
-	m := okx.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, false, m)
+	res, err := c.client.Do(http.MethodGet, p, false, req)
 	if err != nil {
 		return
 	}
@@ -178,8 +169,7 @@ func (c *TradeData) GetOpenInterestAndVolumeStrike(req requests.GetOpenInterestA
 // https://www.okx.com/docs-v5/en/#rest-api-trading-data-get-taker-flow
 func (c *TradeData) GetTakerFlow(req requests.GetRatio) (response responses.GetTakerFlow, err error) {
 	p := "/api/v5/rubik/stat/option/taker-block-volume"
-	m := okx.S2M(req)
-	res, err := c.client.Do(http.MethodGet, p, false, m)
+	res, err := c.client.Do(http.MethodGet, p, false, req)
 	if err != nil {
 		return
 	}
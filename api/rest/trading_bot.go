@@ -0,0 +1,308 @@
+package rest
+
+import (
+	"encoding/json"
+	"net/http"
+
+	requests "github.com/liuhengloveyou/okx-go/requests/rest/tradingbot"
+	responses "github.com/liuhengloveyou/okx-go/responses/trading_bot"
+	"github.com/liuhengloveyou/okx-go/risk"
+)
+
+// TradingBot
+//
+// https://www.okx.com/docs-v5/en/#order-book-trading-grid-trading
+type TradingBot struct {
+	client *ClientRest
+}
+
+// NewTradingBot returns a pointer to a fresh TradingBot
+func NewTradingBot(c *ClientRest) *TradingBot {
+	return &TradingBot{c}
+}
+
+// PlaceGridOrder
+// Place a spot grid or a contract grid algo order, SpotGrid or ContractGrid has to be filled accordingly.
+// The risk gate of the client checks the grid as a single order, see risk.NewGridOrder.
+//
+// https://www.okx.com/docs-v5/en/#order-book-trading-grid-trading-post-place-grid-algo-order
+func (c *TradingBot) PlaceGridOrder(req requests.PlaceGridOrder) (response responses.PlaceGridOrder, err error) {
+	p := "/api/v5/tradingBot/grid/order-algo"
+	if c.client.risk != nil {
+		if err = c.client.risk.Check(risk.NewGridOrder(req)); err != nil {
+			return
+		}
+	}
+	res, err := c.client.Do(http.MethodPost, p, true, req)
+	if err != nil {
+		c.client.release(err, risk.NewGridOrder(req))
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// AmendGridOrder
+// Amend the take profit, stop loss and trigger parameters of a grid algo order.
+//
+// https://www.okx.com/docs-v5/en/#order-book-trading-grid-trading-post-amend-grid-algo-order
+func (c *TradingBot) AmendGridOrder(req requests.AmendGridOrder) (response responses.PlaceGridOrder, err error) {
+	p := "/api/v5/tradingBot/grid/amend-order-algo"
+	if c.client.risk != nil {
+		if err = c.client.risk.CheckKill(req.InstID); err != nil {
+			return
+		}
+	}
+	res, err := c.client.Do(http.MethodPost, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// StopGridOrder
+// Stop grid algo orders. A maximum of 10 orders can be stopped at a time. Request parameters should be passed in the form of an array.
+//
+// https://www.okx.com/docs-v5/en/#order-book-trading-grid-trading-post-stop-grid-algo-order
+func (c *TradingBot) StopGridOrder(req []requests.StopGridOrder) (response responses.PlaceGridOrder, err error) {
+	p := "/api/v5/tradingBot/grid/stop-order-algo"
+	res, err := c.client.DoBatch(p, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// GetGridOrderList
+// Retrieve the list of running grid algo orders.
+//
+// https://www.okx.com/docs-v5/en/#order-book-trading-grid-trading-get-grid-algo-order-list
+//
+// Retrieve the list of stopped grid algo orders.
+//
+// https://www.okx.com/docs-v5/en/#order-book-trading-grid-trading-get-grid-algo-order-history
+func (c *TradingBot) GetGridOrderList(req requests.GridOrderList, arch bool) (response responses.GridOrderList, err error) {
+	p := "/api/v5/tradingBot/grid/orders-algo-pending"
+	if arch {
+		p = "/api/v5/tradingBot/grid/orders-algo-history"
+	}
+	res, err := c.client.Do(http.MethodGet, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// GetGridOrderDetails
+// Retrieve the details of a grid algo order.
+//
+// https://www.okx.com/docs-v5/en/#order-book-trading-grid-trading-get-grid-algo-order-details
+func (c *TradingBot) GetGridOrderDetails(req requests.GridOrderDetails) (response responses.GridOrderList, err error) {
+	p := "/api/v5/tradingBot/grid/orders-algo-details"
+	res, err := c.client.Do(http.MethodGet, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// GetGridSubOrders
+// Retrieve the live or filled orders placed by a grid algo order.
+//
+// https://www.okx.com/docs-v5/en/#order-book-trading-grid-trading-get-grid-algo-sub-orders
+func (c *TradingBot) GetGridSubOrders(req requests.GridSubOrders) (response responses.GridSubOrders, err error) {
+	p := "/api/v5/tradingBot/grid/sub-orders"
+	res, err := c.client.Do(http.MethodGet, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// GetGridPositions
+// Retrieve the position of a contract grid algo order.
+//
+// https://www.okx.com/docs-v5/en/#order-book-trading-grid-trading-get-grid-algo-order-positions
+func (c *TradingBot) GetGridPositions(req requests.GridPositions) (response responses.GridPositions, err error) {
+	p := "/api/v5/tradingBot/grid/positions"
+	res, err := c.client.Do(http.MethodGet, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// GetGridAIParam
+// Retrieve the grid parameters recommended by the AI strategy.
+//
+// https://www.okx.com/docs-v5/en/#order-book-trading-grid-trading-get-grid-ai-parameter-public
+func (c *TradingBot) GetGridAIParam(req requests.GridAIParam) (response responses.GridAIParam, err error) {
+	p := "/api/v5/tradingBot/grid/ai-param"
+	res, err := c.client.Do(http.MethodGet, p, false, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// ComputeMinInvestment
+// Compute the minimum investment of a grid algo order.
+//
+// https://www.okx.com/docs-v5/en/#order-book-trading-grid-trading-post-compute-min-investment-public
+func (c *TradingBot) ComputeMinInvestment(req requests.MinInvestment) (response responses.MinInvestment, err error) {
+	p := "/api/v5/tradingBot/grid/min-investment"
+	res, err := c.client.Do(http.MethodPost, p, false, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// PlaceRecurringOrder
+// Place a recurring buy algo order.
+//
+// https://www.okx.com/docs-v5/en/#order-book-trading-recurring-buy-post-place-recurring-buy-order
+func (c *TradingBot) PlaceRecurringOrder(req requests.PlaceRecurringOrder) (response responses.PlaceGridOrder, err error) {
+	p := "/api/v5/tradingBot/recurring/order-algo"
+	if c.client.risk != nil {
+		if err = c.client.risk.CheckKill(req.InvestmentCcy); err != nil {
+			return
+		}
+	}
+	res, err := c.client.Do(http.MethodPost, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// AmendRecurringOrder
+// Rename a recurring buy algo order.
+//
+// https://www.okx.com/docs-v5/en/#order-book-trading-recurring-buy-post-amend-recurring-buy-order
+func (c *TradingBot) AmendRecurringOrder(req requests.AmendRecurringOrder) (response responses.PlaceGridOrder, err error) {
+	p := "/api/v5/tradingBot/recurring/amend-order-algo"
+	res, err := c.client.Do(http.MethodPost, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// StopRecurringOrder
+// Stop recurring buy algo orders. A maximum of 10 orders can be stopped at a time. Request parameters should be passed in the form of an array.
+//
+// https://www.okx.com/docs-v5/en/#order-book-trading-recurring-buy-post-stop-recurring-buy-order
+func (c *TradingBot) StopRecurringOrder(req []requests.StopRecurringOrder) (response responses.PlaceGridOrder, err error) {
+	p := "/api/v5/tradingBot/recurring/stop-order-algo"
+	res, err := c.client.DoBatch(p, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// GetRecurringOrderList
+// Retrieve the list of running recurring buy algo orders.
+//
+// https://www.okx.com/docs-v5/en/#order-book-trading-recurring-buy-get-recurring-buy-order-list
+//
+// Retrieve the list of stopped recurring buy algo orders.
+//
+// https://www.okx.com/docs-v5/en/#order-book-trading-recurring-buy-get-recurring-buy-order-history
+func (c *TradingBot) GetRecurringOrderList(req requests.RecurringOrderList, arch bool) (response responses.RecurringOrderList, err error) {
+	p := "/api/v5/tradingBot/recurring/orders-algo-pending"
+	if arch {
+		p = "/api/v5/tradingBot/recurring/orders-algo-history"
+	}
+	res, err := c.client.Do(http.MethodGet, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// GetRecurringOrderDetails
+// Retrieve the details of a recurring buy algo order.
+//
+// https://www.okx.com/docs-v5/en/#order-book-trading-recurring-buy-get-recurring-buy-order-details
+func (c *TradingBot) GetRecurringOrderDetails(req requests.RecurringOrderDetails) (response responses.RecurringOrderList, err error) {
+	p := "/api/v5/tradingBot/recurring/orders-algo-details"
+	res, err := c.client.Do(http.MethodGet, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
+
+// GetRecurringSubOrders
+// Retrieve the orders placed by a recurring buy algo order.
+//
+// https://www.okx.com/docs-v5/en/#order-book-trading-recurring-buy-get-recurring-buy-sub-orders
+func (c *TradingBot) GetRecurringSubOrders(req requests.RecurringSubOrders) (response responses.RecurringSubOrders, err error) {
+	p := "/api/v5/tradingBot/recurring/sub-orders"
+	res, err := c.client.Do(http.MethodGet, p, true, req)
+	if err != nil {
+		return
+	}
+	defer res.Body.Close()
+	d := json.NewDecoder(res.Body)
+	err = d.Decode(&response)
+
+	return
+}
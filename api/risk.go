@@ -0,0 +1,161 @@
+package api
+
+import (
+	"fmt"
+
+	"github.com/liuhengloveyou/okx-go"
+	"github.com/liuhengloveyou/okx-go/api/ws"
+	"github.com/liuhengloveyou/okx-go/events/private"
+	"github.com/liuhengloveyou/okx-go/events/public"
+	requests "github.com/liuhengloveyou/okx-go/requests/rest/trade"
+	wsPrivate "github.com/liuhengloveyou/okx-go/requests/ws/private"
+	wsPublic "github.com/liuhengloveyou/okx-go/requests/ws/public"
+	"github.com/liuhengloveyou/okx-go/risk"
+)
+
+// maxKillRounds bounds the rounds of listing and canceling the pending orders of Client.Kill
+const maxKillRounds = 10
+
+// PriceSource is the channel the reference prices of a risk.Gate are taken from
+type PriceSource int
+
+const (
+	// MarkPriceSource takes the reference prices from the mark-price channel
+	MarkPriceSource PriceSource = iota
+	// LastPriceSource takes the reference prices from the last price of the tickers channel
+	LastPriceSource
+)
+
+// RiskWatch feeds a risk.Gate with the orders, the positions and the reference prices pushed on the websocket channels
+type RiskWatch struct {
+	handlers []*ws.Handler
+}
+
+// SetRiskGate set the gate checking every order placed or amended through both the REST and the websocket clients, nil disables it
+func (c *Client) SetRiskGate(g *risk.Gate) {
+	c.Rest.SetRiskGate(g)
+	c.Ws.SetRiskGate(g)
+}
+
+// WatchRisk subscribes to the orders and positions channels of every instrument type, and to the reference prices
+// of instIDs from src, and feeds them to g until RiskWatch.Stop is called.
+//
+// The orders channel only pushes updates, so the orders pending beforehand are loaded through REST,
+// the positions channel pushes a snapshot on its own.
+func (c *Client) WatchRisk(g *risk.Gate, src PriceSource, instIDs ...string) (*RiskWatch, error) {
+	w := &RiskWatch{}
+	h, err := c.Ws.Private.OnOrder(wsPrivate.Order{InstType: okx.AnyInstrument}, func(e *private.Order) {
+		for _, o := range e.Orders {
+			g.TrackOrder(o)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	w.handlers = append(w.handlers, h)
+
+	list, err := c.Rest.Trade.GetOrderList(requests.OrderList{})
+	if err == nil && list.Code != 0 {
+		err = fmt.Errorf("okx: get order list failed, code %d: %s", list.Code, list.Msg)
+	}
+	if err != nil {
+		w.Stop()
+		return nil, err
+	}
+	for _, o := range list.Orders {
+		g.TrackOrder(o)
+	}
+
+	h, err = c.Ws.Private.OnPosition(wsPrivate.Position{InstType: okx.AnyInstrument}, func(e *private.Position) {
+		for _, p := range e.Positions {
+			g.TrackPosition(p)
+		}
+	})
+	if err != nil {
+		w.Stop()
+		return nil, err
+	}
+	w.handlers = append(w.handlers, h)
+
+	for _, instID := range instIDs {
+		switch src {
+		case LastPriceSource:
+			h, err = c.Ws.Public.OnTickers(wsPublic.Tickers{InstID: instID}, func(e *public.Tickers) {
+				for _, t := range e.Tickers {
+					g.SetPrice(t.InstID, float64(t.Last))
+				}
+			})
+		default:
+			h, err = c.Ws.Public.OnMarkPrice(wsPublic.MarkPrice{InstID: instID}, func(e *public.MarkPrice) {
+				for _, p := range e.Prices {
+					g.SetPrice(p.InstID, float64(p.MarkPx))
+				}
+			})
+		}
+		if err != nil {
+			w.Stop()
+			return nil, err
+		}
+		w.handlers = append(w.handlers, h)
+	}
+
+	return w, nil
+}
+
+// Stop unsubscribes from the channels feeding the gate
+func (w *RiskWatch) Stop() {
+	for _, h := range w.handlers {
+		_ = h.Unsubscribe()
+	}
+	w.handlers = nil
+}
+
+// Kill turns the kill switch of g on, then cancels the pending orders of the account through REST when cancelOpen is set.
+//
+// Algo orders aren't canceled. The pending orders are listed and canceled in batches until none is left,
+// an error is returned as soon as a batch cancels none of its orders.
+func (c *Client) Kill(g *risk.Gate, reason string, cancelOpen bool) error {
+	g.Kill(reason)
+	if !cancelOpen {
+		return nil
+	}
+
+	for round := 0; round < maxKillRounds; round++ {
+		list, err := c.Rest.Trade.GetOrderList(requests.OrderList{})
+		if err != nil {
+			return err
+		}
+		if list.Code != 0 {
+			return fmt.Errorf("okx: get order list failed, code %d: %s", list.Code, list.Msg)
+		}
+		if len(list.Orders) == 0 {
+			return nil
+		}
+
+		for i := 0; i < len(list.Orders); i += ws.MaxBatchSize {
+			end := i + ws.MaxBatchSize
+			if end > len(list.Orders) {
+				end = len(list.Orders)
+			}
+			batch := make([]requests.CancelOrder, 0, end-i)
+			for _, o := range list.Orders[i:end] {
+				batch = append(batch, requests.CancelOrder{InstID: o.InstID, OrdID: o.OrdID})
+			}
+			res, err := c.Rest.Trade.CancelOrder(batch)
+			if err != nil {
+				return err
+			}
+			canceled := 0
+			for _, co := range res.CancelOrders {
+				if co.SCode == 0 {
+					canceled++
+				}
+			}
+			if canceled == 0 {
+				return fmt.Errorf("okx: cancel orders failed, code %d: %s", res.Code, res.Msg)
+			}
+		}
+	}
+
+	return fmt.Errorf("okx: orders still pending after %d rounds of cancels", maxKillRounds)
+}
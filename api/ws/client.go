@@ -16,6 +16,7 @@ import (
 	"github.com/gorilla/websocket"
 	"github.com/liuhengloveyou/okx-go"
 	"github.com/liuhengloveyou/okx-go/events"
+	"github.com/liuhengloveyou/okx-go/risk"
 )
 
 // ClientWs is the websocket api client
@@ -37,6 +38,13 @@ type ClientWs struct {
 	UnsubscribeCh chan *events.Unsubscribe
 	LoginChan     chan *events.Login
 	SuccessChan   chan *events.Success
+	RawChan       chan *events.Raw
+	rawHandler    func([]byte, *events.Basic)
+	brokerTag     string
+	clOrdID       okx.ClOrdIDGenerator
+	risk          *risk.Gate
+	limiter       *rateLimiter
+	clock         *okx.Clock
 	sendChan      map[bool]chan []byte
 	lastTransmit  sync.Map
 	AuthRequested *time.Time
@@ -45,6 +53,11 @@ type ClientWs struct {
 	Public        *Public
 	Trade         *Trade
 	WithIP        string
+	handlers      *handlerRegistry
+	decodeErrs    decodeCounters
+	health        map[bool]*connHealth
+	session       sessionState
+	opSeq         uint64
 }
 
 const (
@@ -69,6 +82,10 @@ func NewClient(ctx context.Context, apiKey, secretKey, passphrase string, url ma
 		Cancel:     cancel,
 		sendChan:   map[bool]chan []byte{true: make(chan []byte, 3), false: make(chan []byte, 3)},
 		DoneChan:   make(chan interface{}, 32),
+		handlers:   newHandlerRegistry(),
+		health:     map[bool]*connHealth{true: newConnHealth(), false: newConnHealth()},
+		limiter:    newRateLimiter(),
+		clock:      &okx.Clock{},
 	}
 
 	c.Private = NewPrivate(c)
@@ -95,6 +112,10 @@ func NewClientWithIP(ctx context.Context, apiKey, secretKey, passphrase string,
 		sendChan:   map[bool]chan []byte{true: make(chan []byte, 3), false: make(chan []byte, 3)},
 		DoneChan:   make(chan interface{}, 32),
 		WithIP:     ip,
+		handlers:   newHandlerRegistry(),
+		health:     map[bool]*connHealth{true: newConnHealth(), false: newConnHealth()},
+		limiter:    newRateLimiter(),
+		clock:      &okx.Clock{},
 	}
 
 	c.Private = NewPrivate(c)
@@ -215,7 +236,11 @@ func (c *ClientWs) Unsubscribe(p bool, ch []okx.ChannelName, args map[string]str
 }
 
 // Send message through either connections
-func (c *ClientWs) Send(p bool, op okx.Operation, args []map[string]string, extras ...map[string]string) error {
+func (c *ClientWs) Send(p bool, op okx.Operation, args interface{}, extras ...map[string]string) error {
+	if c.isClosing() {
+		return ErrClosing
+	}
+
 	if op != okx.LoginOperation {
 		err := c.Connect(p)
 		if err == nil {
@@ -246,6 +271,7 @@ func (c *ClientWs) Send(p bool, op okx.Operation, args []map[string]string, extr
 		return err
 	}
 
+	c.track(p, op, args, extras)
 	c.mu[p].RLock()
 	c.sendChan[p] <- j
 	c.mu[p].RUnlock()
@@ -271,6 +297,38 @@ func (c *ClientWs) SetLoginChannel(lCh chan *events.Login) {
 	c.LoginChan = lCh
 }
 
+// SetRawChannel set the channel receiving pushes of channels unknown to Public and Private, undecoded
+func (c *ClientWs) SetRawChannel(rCh chan *events.Raw) {
+	c.RawChan = rCh
+}
+
+// SetRawHandler set a hook receiving every text frame with its parsed envelope before it gets processed.
+//
+// The hook is called from the receiver goroutine in arrival order, so it must not block.
+func (c *ClientWs) SetRawHandler(fn func(data []byte, e *events.Basic)) {
+	c.rawHandler = fn
+}
+
+// SetClock set the server clock used to compute the expTime of operations sent with okx.WithExpiry, such as rest.ClientRest.Clock
+func (c *ClientWs) SetClock(clock *okx.Clock) {
+	c.clock = clock
+}
+
+// SetClOrdIDGenerator set the generator of the ClOrdID of every order placed through Trade whose ClOrdID is left empty, such as okx.NewClOrdIDGenerator
+func (c *ClientWs) SetClOrdIDGenerator(gen okx.ClOrdIDGenerator) {
+	c.clOrdID = gen
+}
+
+// SetBrokerTag set the broker tag stamped on every order placed through Trade whose Tag is left empty
+func (c *ClientWs) SetBrokerTag(tag string) {
+	c.brokerTag = tag
+}
+
+// SetRiskGate set the gate checking every order placed or amended through Trade before it's sent, nil disables it
+func (c *ClientWs) SetRiskGate(g *risk.Gate) {
+	c.risk = g
+}
+
 // WaitForAuthorization waits for the auth response and try to log in if it was needed
 func (c *ClientWs) WaitForAuthorization() error {
 	if c.Authorized {
@@ -331,6 +389,7 @@ func (c *ClientWs) dial(p bool) error {
 	}
 	defer res.Body.Close()
 
+	c.session.wg.Add(2)
 	go func() {
 		defer func() {
 			// Cleaning the connection with ws
@@ -340,10 +399,11 @@ func (c *ClientWs) dial(p bool) error {
 			c.closed[p] = true
 			fmt.Printf("receiver connection closed\n")
 			c.mu[p].Unlock()
+			c.session.wg.Done()
 		}()
 		err := c.receiver(p)
 		if err != nil {
-			if !strings.Contains(err.Error(), "operation cancelled: receiver") {
+			if !strings.Contains(err.Error(), "operation cancelled: receiver") && !c.isClosing() && c.ErrChan != nil {
 				c.ErrChan <- &events.Error{
 					Event: "error",
 					Msg:   err.Error(),
@@ -362,10 +422,11 @@ func (c *ClientWs) dial(p bool) error {
 			c.closed[p] = true
 			fmt.Printf("sender connection closed\n")
 			c.mu[p].Unlock()
+			c.session.wg.Done()
 		}()
 		err := c.sender(p)
 		if err != nil {
-			if !strings.Contains(err.Error(), "operation cancelled: sender") {
+			if !strings.Contains(err.Error(), "operation cancelled: sender") && !c.isClosing() && c.ErrChan != nil {
 				c.ErrChan <- &events.Error{
 					Event: "error",
 					Msg:   err.Error(),
@@ -378,6 +439,7 @@ func (c *ClientWs) dial(p bool) error {
 
 	c.conn[p] = conn
 	c.closed[p] = false
+	c.health[p].dialed()
 	c.mu[p].Unlock()
 
 	return nil
@@ -411,6 +473,9 @@ func (c *ClientWs) sender(p bool) error {
 				c.mu[p].RUnlock()
 				return fmt.Errorf("failed to write data via ws connection, error: %w", err)
 			}
+			if string(data) == "ping" {
+				c.health[p].pinged()
+			}
 
 			c.mu[p].RUnlock()
 
@@ -435,7 +500,28 @@ func (c *ClientWs) sender(p bool) error {
 		}
 	}
 }
+
+// inboxSize is how many messages a connection buffers while its handlers are busy, before it stops reading
+const inboxSize = 1024
+
+// inbound is a message read from a connection waiting to be processed
+type inbound struct {
+	data []byte
+	e    *events.Basic
+}
+
+// receiver reads the messages of the connection and queues them to a single processor goroutine,
+// so the handlers get the pushes of a connection one at a time in the order they were received.
+// The processor is part of the session, Close waits for it to process the queued messages.
 func (c *ClientWs) receiver(p bool) error {
+	inbox := make(chan inbound, inboxSize)
+	defer close(inbox)
+	c.session.wg.Add(1)
+	go func() {
+		defer c.session.wg.Done()
+		c.processor(inbox)
+	}()
+
 	for {
 		select {
 		case <-c.ctx.Done():
@@ -457,18 +543,35 @@ func (c *ClientWs) receiver(p bool) error {
 
 			now := time.Now()
 			c.lastTransmit.Store(p, &now)
+			c.health[p].received(now, mt == websocket.TextMessage && string(data) == "pong")
 
 			if mt == websocket.TextMessage && string(data) != "pong" {
 				e := &events.Basic{}
 				if err := json.Unmarshal(data, e); err != nil {
-					return fmt.Errorf("failed to unmarshall message from ws, error: %w", err)
+					c.decodeError("", data, err)
+					continue
+				}
+				c.health[p].event(now, e)
+				if c.rawHandler != nil {
+					c.rawHandler(data, e)
+				}
+				select {
+				case inbox <- inbound{data: data, e: e}:
+				case <-c.ctx.Done():
+					return c.handleCancel("receiver")
 				}
-				go c.process(data, e)
 			}
 		}
 	}
 }
 
+// processor processes the messages of inbox in order until it's closed
+func (c *ClientWs) processor(inbox <-chan inbound) {
+	for m := range inbox {
+		c.process(m.data, m.e)
+	}
+}
+
 func (c *ClientWs) sign(method, path string) (string, string) {
 	t := time.Now().UTC().Unix()
 	ts := fmt.Sprint(t)
@@ -481,9 +584,10 @@ func (c *ClientWs) sign(method, path string) (string, string) {
 }
 
 func (c *ClientWs) handleCancel(msg string) error {
-	go func() {
-		c.DoneChan <- msg
-	}()
+	select {
+	case c.DoneChan <- msg:
+	default:
+	}
 
 	return fmt.Errorf("operation cancelled: %s", msg)
 }
@@ -493,7 +597,13 @@ func (c *ClientWs) process(data []byte, e *events.Basic) bool {
 	switch e.Event {
 	case "error":
 		e := events.Error{}
-		_ = json.Unmarshal(data, &e)
+		if err := json.Unmarshal(data, &e); err != nil {
+			c.decodeError("error", data, err)
+			return true
+		}
+		if e.ID != "" {
+			c.acked(e.ID)
+		}
 		go func() {
 			if c.ErrChan != nil {
 				c.ErrChan <- &e
@@ -503,15 +613,23 @@ func (c *ClientWs) process(data []byte, e *events.Basic) bool {
 		return true
 	case "subscribe":
 		e := events.Subscribe{}
-		_ = json.Unmarshal(data, &e)
-		if c.SubscribeChan != nil {
-			c.SubscribeChan <- &e
+		if err := json.Unmarshal(data, &e); err != nil {
+			c.decodeError("subscribe", data, err)
+			return true
 		}
+		go func() {
+			if c.SubscribeChan != nil {
+				c.SubscribeChan <- &e
+			}
+		}()
 
 		return true
 	case "unsubscribe":
 		e := events.Unsubscribe{}
-		_ = json.Unmarshal(data, &e)
+		if err := json.Unmarshal(data, &e); err != nil {
+			c.decodeError("unsubscribe", data, err)
+			return true
+		}
 		go func() {
 			if c.UnsubscribeCh != nil {
 				c.UnsubscribeCh <- &e
@@ -529,7 +647,10 @@ func (c *ClientWs) process(data []byte, e *events.Basic) bool {
 		c.Authorized = true
 
 		e := events.Login{}
-		_ = json.Unmarshal(data, &e)
+		if err := json.Unmarshal(data, &e); err != nil {
+			c.decodeError("login", data, err)
+			return true
+		}
 		go func() {
 			if c.LoginChan != nil {
 				c.LoginChan <- &e
@@ -539,6 +660,14 @@ func (c *ClientWs) process(data []byte, e *events.Basic) bool {
 		return true
 	}
 
+	var chName string
+	if e.Event == "" && e.Arg != nil {
+		if ch, ok := e.Arg.Get("channel"); ok {
+			chName = fmt.Sprint(ch)
+			c.dispatchRaw(chName, e.Arg, data)
+		}
+	}
+
 	if c.Private.Process(data, e) {
 		return true
 	}
@@ -547,7 +676,20 @@ func (c *ClientWs) process(data []byte, e *events.Basic) bool {
 		return true
 	}
 
+	if chName != "" && c.RawChan != nil {
+		raw := events.Raw{}
+		if err := json.Unmarshal(data, &raw); err != nil {
+			c.decodeError(chName, data, err)
+			return true
+		}
+		go func() {
+			c.RawChan <- &raw
+		}()
+		return true
+	}
+
 	if e.ID != "" {
+		c.acked(e.ID)
 		if e.Code != 0 {
 			ee := *e
 			ee.Event = "error"
@@ -556,12 +698,17 @@ func (c *ClientWs) process(data []byte, e *events.Basic) bool {
 		}
 
 		e := events.Success{}
-		_ = json.Unmarshal(data, &e)
-
-		if c.SuccessChan != nil {
-			c.SuccessChan <- &e
+		if err := json.Unmarshal(data, &e); err != nil {
+			c.decodeError(string(e.Op), data, err)
+			return true
 		}
 
+		go func() {
+			if c.SuccessChan != nil {
+				c.SuccessChan <- &e
+			}
+		}()
+
 		return true
 	}
 
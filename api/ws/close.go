@@ -0,0 +1,172 @@
+package ws
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/liuhengloveyou/okx-go"
+)
+
+// ErrClosing is returned by Send once Close has been called
+var ErrClosing = errors.New("okx: websocket client is closing")
+
+const drainTick = 50 * time.Millisecond
+
+type sessionState struct {
+	mu      sync.Mutex
+	closing int32
+	wg      sync.WaitGroup
+	pending map[string]struct{}
+	subs    map[bool]map[string]map[string]string
+}
+
+// Close shuts both connections down gracefully: it stops accepting sends, flushes the queued messages,
+// waits for the acks of the order operations in flight, optionally unsubscribes from every channel,
+// sends a close frame and returns once the sender, receiver and processor goroutines have exited,
+// so no handler runs after Close returns.
+//
+// When ctx expires before that the connections are dropped and ctx.Err() is returned.
+func (c *ClientWs) Close(ctx context.Context, unsubscribe ...bool) error {
+	if len(unsubscribe) > 0 && unsubscribe[0] {
+		for _, p := range []bool{false, true} {
+			if !c.CheckConnect(p) {
+				continue
+			}
+			for _, args := range c.subscriptions(p) {
+				_ = c.Unsubscribe(p, []okx.ChannelName{okx.ChannelName(args["channel"])}, args)
+			}
+		}
+	}
+
+	atomic.StoreInt32(&c.session.closing, 1)
+
+	err := c.drain(ctx)
+
+	for _, p := range []bool{false, true} {
+		c.mu[p].RLock()
+		if c.conn[p] != nil && !c.closed[p] {
+			msg := websocket.FormatCloseMessage(websocket.CloseNormalClosure, "")
+			_ = c.conn[p].WriteControl(websocket.CloseMessage, msg, time.Now().Add(writeWait))
+		}
+		c.mu[p].RUnlock()
+	}
+
+	done := make(chan struct{})
+	go func() {
+		c.session.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		c.Cancel()
+		return err
+	case <-ctx.Done():
+	}
+
+	// the server didn't answer the close frame in time
+	c.Cancel()
+	for _, p := range []bool{false, true} {
+		c.mu[p].RLock()
+		if c.conn[p] != nil {
+			_ = c.conn[p].Close()
+		}
+		c.mu[p].RUnlock()
+	}
+	<-done
+
+	return ctx.Err()
+}
+
+// drain waits until the send queues are empty and every order operation got its ack
+func (c *ClientWs) drain(ctx context.Context) error {
+	ticker := time.NewTicker(drainTick)
+	defer ticker.Stop()
+
+	for {
+		c.session.mu.Lock()
+		pending := len(c.session.pending)
+		c.session.mu.Unlock()
+		if pending == 0 && len(c.sendChan[true]) == 0 && len(c.sendChan[false]) == 0 {
+			return nil
+		}
+		if !c.CheckConnect(true) && !c.CheckConnect(false) {
+			return nil
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (c *ClientWs) isClosing() bool {
+	return atomic.LoadInt32(&c.session.closing) == 1
+}
+
+// track keeps the subscriptions and the order operations in flight up to date with what's sent
+func (c *ClientWs) track(p bool, op okx.Operation, args interface{}, extras []map[string]string) {
+	c.session.mu.Lock()
+	defer c.session.mu.Unlock()
+
+	switch op {
+	case okx.LoginOperation:
+	case okx.SubscribeOperation, okx.UnsubscribeOperation:
+		if c.session.subs == nil {
+			c.session.subs = map[bool]map[string]map[string]string{true: {}, false: {}}
+		}
+		list, _ := args.([]map[string]string)
+		for _, a := range list {
+			if op == okx.SubscribeOperation {
+				c.session.subs[p][argsKey(a)] = a
+			} else {
+				delete(c.session.subs[p], argsKey(a))
+			}
+		}
+	default:
+		for _, extra := range extras {
+			if id := extra["id"]; id != "" {
+				if c.session.pending == nil {
+					c.session.pending = make(map[string]struct{})
+				}
+				c.session.pending[id] = struct{}{}
+			}
+		}
+	}
+}
+
+// acked marks the operation with the given id as answered
+func (c *ClientWs) acked(id string) {
+	c.session.mu.Lock()
+	delete(c.session.pending, id)
+	c.session.mu.Unlock()
+}
+
+func (c *ClientWs) subscriptions(p bool) []map[string]string {
+	c.session.mu.Lock()
+	defer c.session.mu.Unlock()
+	res := make([]map[string]string, 0, len(c.session.subs[p]))
+	for _, a := range c.session.subs[p] {
+		res = append(res, a)
+	}
+
+	return res
+}
+
+func argsKey(args map[string]string) string {
+	keys := make([]string, 0, len(args))
+	for k, v := range args {
+		keys = append(keys, k+"="+v)
+	}
+	sort.Strings(keys)
+
+	return strings.Join(keys, "&")
+}
@@ -0,0 +1,55 @@
+package ws
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/liuhengloveyou/okx-go/events"
+)
+
+func TestCloseWaitsForHandlers(t *testing.T) {
+	srv := newTestServer(t, func(conn *websocket.Conn) {
+		// the subscription, then a push, then wait for the close frame
+		if _, _, err := conn.ReadMessage(); err != nil {
+			return
+		}
+		_ = conn.WriteMessage(websocket.TextMessage, []byte(`{"arg":{"channel":"status"},"data":[]}`))
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	})
+	c := newTestClient(t, srv)
+
+	started := make(chan struct{})
+	var finished int32
+	_, err := c.SubscribeRaw(false, map[string]string{"channel": "status"}, func(*events.Raw) {
+		close(started)
+		time.Sleep(200 * time.Millisecond)
+		atomic.StoreInt32(&finished, 1)
+	})
+	if err != nil {
+		t.Fatalf("SubscribeRaw() = %v", err)
+	}
+	select {
+	case <-started:
+	case <-time.After(5 * time.Second):
+		t.Fatal("handler not called")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := c.Close(ctx); err != nil {
+		t.Fatalf("Close() = %v", err)
+	}
+	if atomic.LoadInt32(&finished) != 1 {
+		t.Error("Close() returned while a handler was running")
+	}
+	if err := c.Send(false, "subscribe", nil); err != ErrClosing {
+		t.Errorf("Send() after Close = %v, want ErrClosing", err)
+	}
+}
@@ -0,0 +1,62 @@
+package ws
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/liuhengloveyou/okx-go/events"
+)
+
+// DecodeErrorEvent is the Event of the errors pushed into ErrChan when a message could not be decoded
+const DecodeErrorEvent = "decode_error"
+
+type decodeCounters struct {
+	mu     sync.RWMutex
+	counts map[string]*int64
+}
+
+// DecodeErrors returns the number of messages that failed to decode so far, per channel (or event name)
+func (c *ClientWs) DecodeErrors() map[string]int64 {
+	c.decodeErrs.mu.RLock()
+	defer c.decodeErrs.mu.RUnlock()
+	m := make(map[string]int64, len(c.decodeErrs.counts))
+	for k, v := range c.decodeErrs.counts {
+		m[k] = atomic.LoadInt64(v)
+	}
+
+	return m
+}
+
+// decodeError counts the failure and reports it on ErrChan with the channel, the raw payload and the underlying error
+func (c *ClientWs) decodeError(ch string, data []byte, err error) {
+	c.decodeErrs.mu.RLock()
+	n, ok := c.decodeErrs.counts[ch]
+	c.decodeErrs.mu.RUnlock()
+	if !ok {
+		c.decodeErrs.mu.Lock()
+		if c.decodeErrs.counts == nil {
+			c.decodeErrs.counts = make(map[string]*int64)
+		}
+		if n, ok = c.decodeErrs.counts[ch]; !ok {
+			n = new(int64)
+			c.decodeErrs.counts[ch] = n
+		}
+		c.decodeErrs.mu.Unlock()
+	}
+	atomic.AddInt64(n, 1)
+
+	raw := make([]byte, len(data))
+	copy(raw, data)
+	e := &events.Error{
+		Event:   DecodeErrorEvent,
+		Msg:     err.Error(),
+		Channel: ch,
+		Raw:     raw,
+		Err:     err,
+	}
+	go func() {
+		if c.ErrChan != nil {
+			c.ErrChan <- e
+		}
+	}()
+}
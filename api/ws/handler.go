@@ -0,0 +1,190 @@
+package ws
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/liuhengloveyou/okx-go"
+	"github.com/liuhengloveyou/okx-go/events"
+)
+
+// Handler is a callback registration returned by the On* methods.
+//
+// Any number of handlers can listen on the same channel, each one receives only the pushes
+// matching the arguments it was registered with (e.g. the instId of a tickers subscription).
+//
+// The handlers of a connection are called one at a time, from a single goroutine, in the order the pushes were received,
+// so a handler never sees the pushes of its channel out of order. A slow handler holds back every other one of its connection.
+type Handler struct {
+	c    *ClientWs
+	id   uint64
+	p    bool
+	args map[string]string
+}
+
+type handlerEntry struct {
+	id   uint64
+	p    bool
+	raw  bool
+	args map[string]string
+	fn   func(interface{})
+}
+
+type handlerRegistry struct {
+	mu      sync.RWMutex
+	nextID  uint64
+	entries map[string][]*handlerEntry
+}
+
+func newHandlerRegistry() *handlerRegistry {
+	return &handlerRegistry{entries: make(map[string][]*handlerEntry)}
+}
+
+// Unsubscribe removes the handler, the channel is unsubscribed from the server once its last handler is removed
+func (h *Handler) Unsubscribe() error {
+	ch := h.args["channel"]
+	if !h.c.handlers.remove(ch, h.id, h.p, h.args) {
+		return nil
+	}
+
+	return h.c.Unsubscribe(h.p, []okx.ChannelName{okx.ChannelName(ch)}, h.args)
+}
+
+// SubscribeRaw subscribes to any channel and delivers its pushes undecoded, args must contain the channel name.
+//
+// It works for channels the library has no typed support for yet (e.g. liquidation-orders, status or newly launched ones).
+func (c *ClientWs) SubscribeRaw(p bool, args map[string]string, fn func(*events.Raw)) (*Handler, error) {
+	return c.register(p, true, args, func(e interface{}) { fn(e.(*events.Raw)) })
+}
+
+// on registers fn for the channel named in args["channel"], subscribing to it if it is the first handler for these args
+func (c *ClientWs) on(p bool, args map[string]string, fn func(interface{})) (*Handler, error) {
+	return c.register(p, false, args, fn)
+}
+
+func (c *ClientWs) register(p, raw bool, args map[string]string, fn func(interface{})) (*Handler, error) {
+	ch, ok := args["channel"]
+	if !ok || ch == "" {
+		return nil, fmt.Errorf("okx: handler registered without channel")
+	}
+
+	r := c.handlers
+	r.mu.Lock()
+	r.nextID++
+	e := &handlerEntry{id: r.nextID, p: p, raw: raw, args: args, fn: fn}
+	first := r.count(ch, p, args) == 0
+	r.entries[ch] = append(r.entries[ch], e)
+	r.mu.Unlock()
+
+	h := &Handler{c: c, id: e.id, p: p, args: args}
+	if !first {
+		return h, nil
+	}
+
+	if err := c.Subscribe(p, []okx.ChannelName{}, args); err != nil {
+		// the server never subscribed, so only the registration is undone
+		r.remove(ch, e.id, p, args)
+		return nil, err
+	}
+
+	return h, nil
+}
+
+// dispatch calls every typed handler of the channel whose arguments match the pushed arg
+func (c *ClientWs) dispatch(ch string, arg *events.Argument, e interface{}) {
+	for _, fn := range c.handlers.match(ch, false, arg) {
+		fn(e)
+	}
+}
+
+// dispatchRaw decodes data into an events.Raw only when a raw handler is listening on the channel
+func (c *ClientWs) dispatchRaw(ch string, arg *events.Argument, data []byte) {
+	fns := c.handlers.match(ch, true, arg)
+	if len(fns) == 0 {
+		return
+	}
+	e := events.Raw{}
+	if err := json.Unmarshal(data, &e); err != nil {
+		c.decodeError(ch, data, err)
+		return
+	}
+	for _, fn := range fns {
+		fn(&e)
+	}
+}
+
+func (r *handlerRegistry) match(ch string, raw bool, arg *events.Argument) []func(interface{}) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var fns []func(interface{})
+	for _, h := range r.entries[ch] {
+		if h.raw == raw && matchArgs(h.args, arg) {
+			fns = append(fns, h.fn)
+		}
+	}
+
+	return fns
+}
+
+// remove removes the handler id and reports whether it was the last one of the channel for these args
+func (r *handlerRegistry) remove(ch string, id uint64, p bool, args map[string]string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	entries := r.entries[ch]
+	found := false
+	for i, e := range entries {
+		if e.id == id {
+			r.entries[ch] = append(entries[:i:i], entries[i+1:]...)
+			found = true
+			break
+		}
+	}
+	if len(r.entries[ch]) == 0 {
+		delete(r.entries, ch)
+	}
+
+	return found && r.count(ch, p, args) == 0
+}
+
+// count must be called with the lock held
+func (r *handlerRegistry) count(ch string, p bool, args map[string]string) int {
+	n := 0
+	for _, e := range r.entries[ch] {
+		if e.p == p && equalArgs(e.args, args) {
+			n++
+		}
+	}
+
+	return n
+}
+
+func matchArgs(args map[string]string, arg *events.Argument) bool {
+	if arg == nil {
+		return false
+	}
+	for k, v := range args {
+		if k == "channel" || v == "" {
+			continue
+		}
+		got, ok := arg.Get(k)
+		if !ok || fmt.Sprint(got) != v {
+			return false
+		}
+	}
+
+	return true
+}
+
+func equalArgs(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if w, ok := b[k]; !ok || w != v {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,61 @@
+package ws
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/liuhengloveyou/okx-go/events"
+)
+
+func TestMatchArgs(t *testing.T) {
+	tests := []struct {
+		name string
+		args map[string]string
+		arg  string
+		want bool
+	}{
+		{name: "channel only", args: map[string]string{"channel": "tickers"}, arg: `{"channel":"tickers","instId":"BTC-USDT"}`, want: true},
+		{name: "same instrument", args: map[string]string{"channel": "tickers", "instId": "BTC-USDT"}, arg: `{"channel":"tickers","instId":"BTC-USDT"}`, want: true},
+		{name: "other instrument", args: map[string]string{"channel": "tickers", "instId": "BTC-USDT"}, arg: `{"channel":"tickers","instId":"ETH-USDT"}`},
+		{name: "missing arg", args: map[string]string{"channel": "orders", "instType": "SPOT"}, arg: `{"channel":"orders"}`},
+		{name: "empty arg ignored", args: map[string]string{"channel": "orders", "instId": ""}, arg: `{"channel":"orders","instType":"SPOT"}`, want: true},
+		{name: "number", args: map[string]string{"channel": "grid-positions", "algoId": "42"}, arg: `{"channel":"grid-positions","algoId":42}`, want: true},
+		{name: "no arg", args: map[string]string{"channel": "tickers"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var arg *events.Argument
+			if tt.arg != "" {
+				arg = &events.Argument{}
+				if err := json.Unmarshal([]byte(tt.arg), arg); err != nil {
+					t.Fatalf("unmarshal %s: %v", tt.arg, err)
+				}
+			}
+			if got := matchArgs(tt.args, arg); got != tt.want {
+				t.Errorf("matchArgs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRegisterSubscribeError(t *testing.T) {
+	c := NewClient(context.Background(), "", "", "", nil)
+	if err := c.Close(context.Background()); err != nil {
+		t.Fatalf("Close() = %v", err)
+	}
+
+	args := map[string]string{"channel": "status"}
+	for i := 0; i < 2; i++ {
+		if _, err := c.SubscribeRaw(false, args, func(*events.Raw) {}); err != ErrClosing {
+			t.Fatalf("SubscribeRaw() = %v, want ErrClosing", err)
+		}
+		// the failed registration is undone, so the next one subscribes again
+		c.handlers.mu.RLock()
+		n := len(c.handlers.entries)
+		c.handlers.mu.RUnlock()
+		if n != 0 {
+			t.Fatalf("%d channels left registered after a failed subscription", n)
+		}
+	}
+}
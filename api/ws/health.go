@@ -0,0 +1,249 @@
+package ws
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/liuhengloveyou/okx-go/events"
+)
+
+type (
+	// Health is a snapshot of the liveness of one connection
+	Health struct {
+		Connected   bool
+		ConnectedAt time.Time
+		Reconnects  int64
+		Messages    int64
+		MsgRate     float64 // messages per second since the connection was established
+		LastMessage time.Time
+		PingSent    time.Time
+		LastPong    time.Time
+		PingRTT     time.Duration
+		Feeds       []FeedHealth
+	}
+	// FeedHealth is a snapshot of the pushes received for one subscription
+	FeedHealth struct {
+		Channel      string
+		Key          string // instId, algoId or sprdId, or instType/ccy for subscriptions not bound to an instrument
+		Messages     int64
+		MsgRate      float64 // messages per second since subscribed
+		SubscribedAt time.Time
+		LastMessage  time.Time
+	}
+	// StaleFeed is reported by WatchStale when a subscription hasn't been pushed within the window
+	StaleFeed struct {
+		Private     bool
+		Channel     string
+		Key         string
+		LastMessage time.Time
+		Since       time.Duration
+	}
+)
+
+type connHealth struct {
+	mu          sync.Mutex
+	dials       int64
+	msgs        int64
+	connectedAt time.Time
+	lastMsg     time.Time
+	pingSent    time.Time
+	lastPong    time.Time
+	rtt         time.Duration
+	feeds       map[string]*feedHealth
+}
+
+type feedHealth struct {
+	channel      string
+	key          string
+	msgs         int64
+	subscribedAt time.Time
+	lastMsg      time.Time
+}
+
+func newConnHealth() *connHealth {
+	return &connHealth{feeds: make(map[string]*feedHealth)}
+}
+
+// Health returns the current health of either connections
+func (c *ClientWs) Health(p bool) Health {
+	h := c.health[p]
+	now := time.Now()
+	// dial holds c.mu while it resets h, so c.mu must never be taken while holding h.mu
+	connected := c.CheckConnect(p)
+
+	h.mu.Lock()
+	res := Health{
+		Connected:   connected,
+		ConnectedAt: h.connectedAt,
+		Messages:    h.msgs,
+		MsgRate:     rate(h.msgs, h.connectedAt, now),
+		LastMessage: h.lastMsg,
+		PingSent:    h.pingSent,
+		LastPong:    h.lastPong,
+		PingRTT:     h.rtt,
+	}
+	if h.dials > 1 {
+		res.Reconnects = h.dials - 1
+	}
+	for _, f := range h.feeds {
+		res.Feeds = append(res.Feeds, FeedHealth{
+			Channel:      f.channel,
+			Key:          f.key,
+			Messages:     f.msgs,
+			MsgRate:      rate(f.msgs, f.subscribedAt, now),
+			SubscribedAt: f.subscribedAt,
+			LastMessage:  f.lastMsg,
+		})
+	}
+	h.mu.Unlock()
+
+	sort.Slice(res.Feeds, func(i, j int) bool {
+		if res.Feeds[i].Channel != res.Feeds[j].Channel {
+			return res.Feeds[i].Channel < res.Feeds[j].Channel
+		}
+		return res.Feeds[i].Key < res.Feeds[j].Key
+	})
+
+	return res
+}
+
+// WatchStale calls fn once every time a subscription of either connections hasn't been pushed for longer than window,
+// it's called again for the same subscription only after pushes resumed and stopped once more.
+//
+// OKX sometimes silently stops pushing a single channel while the connection stays healthy, this detects it.
+// The watcher stops with the client context or when the returned stop func is called.
+func (c *ClientWs) WatchStale(window time.Duration, fn func(StaleFeed)) (stop func()) {
+	tick := window / 4
+	if tick < 100*time.Millisecond {
+		tick = 100 * time.Millisecond
+	}
+	done := make(chan struct{})
+	var once sync.Once
+
+	go func() {
+		ticker := time.NewTicker(tick)
+		defer ticker.Stop()
+		reported := map[bool]map[string]bool{true: {}, false: {}}
+
+		for {
+			select {
+			case <-ticker.C:
+				now := time.Now()
+				for _, p := range []bool{false, true} {
+					for _, f := range c.Health(p).Feeds {
+						k := f.Channel + ":" + f.Key
+						last := f.LastMessage
+						if last.IsZero() {
+							last = f.SubscribedAt
+						}
+						if now.Sub(last) <= window {
+							delete(reported[p], k)
+							continue
+						}
+						if reported[p][k] {
+							continue
+						}
+						reported[p][k] = true
+						fn(StaleFeed{Private: p, Channel: f.Channel, Key: f.Key, LastMessage: f.LastMessage, Since: now.Sub(last)})
+					}
+				}
+			case <-done:
+				return
+			case <-c.ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return func() { once.Do(func() { close(done) }) }
+}
+
+func (h *connHealth) dialed() {
+	h.mu.Lock()
+	h.dials++
+	h.msgs = 0
+	h.connectedAt = time.Now()
+	h.feeds = make(map[string]*feedHealth)
+	h.mu.Unlock()
+}
+
+func (h *connHealth) pinged() {
+	h.mu.Lock()
+	h.pingSent = time.Now()
+	h.mu.Unlock()
+}
+
+func (h *connHealth) received(now time.Time, pong bool) {
+	h.mu.Lock()
+	h.msgs++
+	h.lastMsg = now
+	if pong {
+		h.lastPong = now
+		if !h.pingSent.IsZero() {
+			h.rtt = now.Sub(h.pingSent)
+		}
+	}
+	h.mu.Unlock()
+}
+
+// event keeps track of the subscriptions and their pushes
+func (h *connHealth) event(now time.Time, e *events.Basic) {
+	var arg *events.Argument
+	switch e.Event {
+	case "", "subscribe", "unsubscribe":
+		arg = e.Arg
+	}
+	if arg == nil {
+		return
+	}
+	ch, ok := arg.Get("channel")
+	if !ok {
+		return
+	}
+	channel := fmt.Sprint(ch)
+	key := feedKey(arg)
+	k := channel + ":" + key
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	switch e.Event {
+	case "subscribe":
+		if _, ok := h.feeds[k]; !ok {
+			h.feeds[k] = &feedHealth{channel: channel, key: key, subscribedAt: now}
+		}
+	case "unsubscribe":
+		delete(h.feeds, k)
+	default:
+		f, ok := h.feeds[k]
+		if !ok {
+			f = &feedHealth{channel: channel, key: key, subscribedAt: now}
+			h.feeds[k] = f
+		}
+		f.msgs++
+		f.lastMsg = now
+	}
+}
+
+func feedKey(arg *events.Argument) string {
+	for _, k := range []string{"instId", "algoId", "sprdId", "instFamily", "uly", "instType", "ccy"} {
+		if v, ok := arg.Get(k); ok && v != "" {
+			return fmt.Sprint(v)
+		}
+	}
+
+	return ""
+}
+
+func rate(n int64, since, now time.Time) float64 {
+	if since.IsZero() {
+		return 0
+	}
+	d := now.Sub(since).Seconds()
+	if d <= 0 {
+		return 0
+	}
+
+	return float64(n) / d
+}
@@ -0,0 +1,80 @@
+package ws
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/liuhengloveyou/okx-go"
+)
+
+// newTestServer returns a websocket server calling serve with every connection, closed when serve returns
+func newTestServer(t *testing.T, serve func(conn *websocket.Conn)) *httptest.Server {
+	t.Helper()
+	upgrader := websocket.Upgrader{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		serve(conn)
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+// newTestClient returns a client whose public and private urls both point to srv
+func newTestClient(t *testing.T, srv *httptest.Server) *ClientWs {
+	t.Helper()
+	u := okx.BaseURL("ws" + strings.TrimPrefix(srv.URL, "http"))
+	c := NewClient(context.Background(), "key", "secret", "pass", map[bool]okx.BaseURL{true: u, false: u})
+	t.Cleanup(c.Cancel)
+
+	return c
+}
+
+func TestHealthDuringReconnect(t *testing.T) {
+	// every connection is dropped right away so the client keeps reconnecting
+	srv := newTestServer(t, func(conn *websocket.Conn) {})
+	c := newTestClient(t, srv)
+
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 20; i++ {
+			if err := c.dial(false); err != nil {
+				t.Errorf("dial() = %v", err)
+				return
+			}
+			c.session.wg.Wait()
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 2000; i++ {
+			_ = c.Health(false)
+		}
+	}()
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("Health and dial deadlocked")
+	}
+	if h := c.Health(false); h.Reconnects != 19 {
+		t.Errorf("Reconnects = %d, want 19", h.Reconnects)
+	}
+}
@@ -0,0 +1,100 @@
+package ws
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/liuhengloveyou/okx-go"
+)
+
+// MaxBatchSize is the maximum number of orders OKX accepts in a single batch operation, larger batches are split
+const MaxBatchSize = 20
+
+type (
+	// RateLimit is the number of orders an operation accepts within a window, per instrument unless PerUser is set
+	RateLimit struct {
+		N       int
+		Window  time.Duration
+		PerUser bool
+	}
+	rateLimiter struct {
+		mu     sync.Mutex
+		limits map[okx.Operation]RateLimit
+		sent   map[string][]time.Time
+	}
+)
+
+// DefaultRateLimits are the websocket trade rate limits documented by OKX
+var DefaultRateLimits = map[okx.Operation]RateLimit{
+	okx.OrderOperation:            {N: 60, Window: 2 * time.Second},
+	okx.BatchOrderOperation:       {N: 300, Window: 2 * time.Second},
+	okx.CancelOrderOperation:      {N: 60, Window: 2 * time.Second},
+	okx.BatchCancelOrderOperation: {N: 300, Window: 2 * time.Second},
+	okx.AmendOrderOperation:       {N: 60, Window: 2 * time.Second},
+	okx.BatchAmendOrderOperation:  {N: 300, Window: 2 * time.Second},
+	okx.MassCancelOperation:       {N: 5, Window: 2 * time.Second, PerUser: true},
+	okx.SprdOrderOperation:        {N: 20, Window: 2 * time.Second, PerUser: true},
+	okx.SprdCancelOrderOperation:  {N: 20, Window: 2 * time.Second, PerUser: true},
+	okx.SprdAmendOrderOperation:   {N: 20, Window: 2 * time.Second, PerUser: true},
+	okx.SprdMassCancelOperation:   {N: 5, Window: 2 * time.Second, PerUser: true},
+}
+
+func newRateLimiter() *rateLimiter {
+	limits := make(map[okx.Operation]RateLimit, len(DefaultRateLimits))
+	for op, l := range DefaultRateLimits {
+		limits[op] = l
+	}
+
+	return &rateLimiter{limits: limits, sent: make(map[string][]time.Time)}
+}
+
+// SetRateLimit overrides the client-side rate limit of an operation, a zero N disables it
+func (c *ClientWs) SetRateLimit(op okx.Operation, l RateLimit) {
+	c.limiter.mu.Lock()
+	c.limiter.limits[op] = l
+	c.limiter.mu.Unlock()
+}
+
+// wait blocks until one more order of instID can be sent with op without exceeding its rate limit
+func (r *rateLimiter) wait(ctx context.Context, op okx.Operation, instID string) error {
+	for {
+		d := r.reserve(op, instID, time.Now())
+		if d == 0 {
+			return nil
+		}
+		t := time.NewTimer(d)
+		select {
+		case <-t.C:
+		case <-ctx.Done():
+			t.Stop()
+			return ctx.Err()
+		}
+	}
+}
+
+// reserve records the order and returns 0 when it's within the limit, or how long to wait before trying again
+func (r *rateLimiter) reserve(op okx.Operation, instID string, now time.Time) time.Duration {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	l := r.limits[op]
+	if l.N <= 0 {
+		return 0
+	}
+	k := string(op)
+	if !l.PerUser {
+		k += ":" + instID
+	}
+	sent := r.sent[k]
+	for len(sent) > 0 && now.Sub(sent[0]) >= l.Window {
+		sent = sent[1:]
+	}
+	if len(sent) >= l.N {
+		r.sent[k] = sent
+		return sent[0].Add(l.Window).Sub(now)
+	}
+	r.sent[k] = append(sent, now)
+
+	return 0
+}
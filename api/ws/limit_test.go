@@ -0,0 +1,86 @@
+package ws
+
+import (
+	"testing"
+	"time"
+
+	"github.com/liuhengloveyou/okx-go"
+)
+
+func TestRateLimiterReserve(t *testing.T) {
+	type call struct {
+		op     okx.Operation
+		instID string
+		at     time.Duration // since the start
+		want   time.Duration
+	}
+	tests := []struct {
+		name  string
+		calls []call
+	}{
+		{
+			name: "within the limit",
+			calls: []call{
+				{op: okx.OrderOperation, instID: "BTC-USDT"},
+				{op: okx.OrderOperation, instID: "BTC-USDT", at: time.Second},
+			},
+		},
+		{
+			name: "over the limit",
+			calls: []call{
+				{op: okx.OrderOperation, instID: "BTC-USDT"},
+				{op: okx.OrderOperation, instID: "BTC-USDT", at: time.Second},
+				{op: okx.OrderOperation, instID: "BTC-USDT", at: time.Second, want: time.Second},
+				{op: okx.OrderOperation, instID: "BTC-USDT", at: 1500 * time.Millisecond, want: 500 * time.Millisecond},
+			},
+		},
+		{
+			name: "window passed",
+			calls: []call{
+				{op: okx.OrderOperation, instID: "BTC-USDT"},
+				{op: okx.OrderOperation, instID: "BTC-USDT"},
+				{op: okx.OrderOperation, instID: "BTC-USDT", at: 2 * time.Second},
+			},
+		},
+		{
+			name: "per instrument",
+			calls: []call{
+				{op: okx.OrderOperation, instID: "BTC-USDT"},
+				{op: okx.OrderOperation, instID: "BTC-USDT"},
+				{op: okx.OrderOperation, instID: "ETH-USDT"},
+				{op: okx.AmendOrderOperation, instID: "BTC-USDT"},
+			},
+		},
+		{
+			name: "per user",
+			calls: []call{
+				{op: okx.MassCancelOperation, instID: "BTC-USDT"},
+				{op: okx.MassCancelOperation, instID: "ETH-USDT"},
+				{op: okx.MassCancelOperation, instID: "SOL-USDT", want: 2 * time.Second},
+			},
+		},
+		{
+			name: "disabled",
+			calls: []call{
+				{op: okx.SprdOrderOperation},
+				{op: okx.SprdOrderOperation},
+				{op: okx.SprdOrderOperation},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newRateLimiter()
+			r.limits[okx.OrderOperation] = RateLimit{N: 2, Window: 2 * time.Second}
+			r.limits[okx.AmendOrderOperation] = RateLimit{N: 1, Window: 2 * time.Second}
+			r.limits[okx.MassCancelOperation] = RateLimit{N: 2, Window: 2 * time.Second, PerUser: true}
+			r.limits[okx.SprdOrderOperation] = RateLimit{}
+			start := time.Now()
+			for i, c := range tt.calls {
+				if got := r.reserve(c.op, c.instID, start.Add(c.at)); got != c.want {
+					t.Errorf("call %d reserve(%s, %s) = %v, want %v", i, c.op, c.instID, got, c.want)
+				}
+			}
+		})
+	}
+}
@@ -2,6 +2,8 @@ package ws
 
 import (
 	"encoding/json"
+	"fmt"
+
 	"github.com/liuhengloveyou/okx-go"
 	"github.com/liuhengloveyou/okx-go/events"
 	"github.com/liuhengloveyou/okx-go/events/private"
@@ -10,15 +12,27 @@ import (
 
 // Private
 //
+// The spread, block trading, trading bot and copy trading channels are served on the business websocket url, see okx.BusinessServer.
+//
 // https://www.okx.com/docs-v5/en/#websocket-api-private-channel
 type Private struct {
 	*ClientWs
-	aCh   chan *private.Account
-	pCh   chan *private.Position
-	bnpCh chan *private.BalanceAndPosition
-	oCh   chan *private.Order
-	aoCh  chan *private.AlgoOrder
-	aaoCh chan *private.AlgoOrder
+	aCh    chan *private.Account
+	pCh    chan *private.Position
+	bnpCh  chan *private.BalanceAndPosition
+	oCh    chan *private.Order
+	aoCh   chan *private.AlgoOrder
+	aaoCh  chan *private.AlgoOrder
+	soCh   chan *private.SpreadOrders
+	strCh  chan *private.SpreadTrades
+	rfqCh  chan *private.RFQs
+	qCh    chan *private.Quotes
+	sbtCh  chan *private.BlockTrades
+	gsoCh  chan *private.GridOrders
+	gcoCh  chan *private.GridOrders
+	gpCh   chan *private.GridPositions
+	gsubCh chan *private.GridSubOrders
+	ctnCh  chan *private.CopyTradingNotification
 }
 
 // NewPrivate returns a pointer to a fresh Private
@@ -31,7 +45,10 @@ func NewPrivate(c *ClientWs) *Private {
 //
 // https://www.okx.com/docs-v5/en/#websocket-api-private-channel-account-channel
 func (c *Private) Account(req requests.Account, ch ...chan *private.Account) error {
-	m := okx.S2M(req)
+	m, err := okx.EncodeQuery(req)
+	if err != nil {
+		return err
+	}
 	if len(ch) > 0 {
 		c.aCh = ch[0]
 	}
@@ -42,7 +59,10 @@ func (c *Private) Account(req requests.Account, ch ...chan *private.Account) err
 //
 // https://www.okx.com/docs-v5/en/#websocket-api-private-channel-account-channel
 func (c *Private) UAccount(req requests.Account, rCh ...bool) error {
-	m := okx.S2M(req)
+	m, err := okx.EncodeQuery(req)
+	if err != nil {
+		return err
+	}
 	if len(rCh) > 0 && rCh[0] {
 		c.aCh = nil
 	}
@@ -54,7 +74,10 @@ func (c *Private) UAccount(req requests.Account, rCh ...bool) error {
 //
 // https://www.okx.com/docs-v5/en/#websocket-api-private-channel-positions-channel
 func (c *Private) Position(req requests.Position, ch ...chan *private.Position) error {
-	m := okx.S2M(req)
+	m, err := okx.EncodeQuery(req)
+	if err != nil {
+		return err
+	}
 	if len(ch) > 0 {
 		c.pCh = ch[0]
 	}
@@ -65,7 +88,10 @@ func (c *Private) Position(req requests.Position, ch ...chan *private.Position)
 //
 // https://www.okx.com/docs-v5/en/#websocket-api-private-channel-positions-channel
 func (c *Private) UPosition(req requests.Position, rCh ...bool) error {
-	m := okx.S2M(req)
+	m, err := okx.EncodeQuery(req)
+	if err != nil {
+		return err
+	}
 	if len(rCh) > 0 && rCh[0] {
 		c.pCh = nil
 	}
@@ -100,7 +126,10 @@ func (c *Private) UBalanceAndPosition(rCh ...bool) error {
 //
 // https://www.okx.com/docs-v5/en/#websocket-api-private-channel-order-channel
 func (c *Private) Order(req requests.Order, ch ...chan *private.Order) error {
-	m := okx.S2M(req)
+	m, err := okx.EncodeQuery(req)
+	if err != nil {
+		return err
+	}
 	if len(ch) > 0 {
 		c.oCh = ch[0]
 	}
@@ -111,7 +140,10 @@ func (c *Private) Order(req requests.Order, ch ...chan *private.Order) error {
 //
 // https://www.okx.com/docs-v5/en/#websocket-api-private-channel-order-channel
 func (c *Private) UOrder(req requests.Order, rCh ...bool) error {
-	m := okx.S2M(req)
+	m, err := okx.EncodeQuery(req)
+	if err != nil {
+		return err
+	}
 	if len(rCh) > 0 && rCh[0] {
 		c.oCh = nil
 	}
@@ -123,7 +155,10 @@ func (c *Private) UOrder(req requests.Order, rCh ...bool) error {
 //
 // https://www.okx.com/docs-v5/en/#websocket-api-private-channel-algo-orders-channel
 func (c *Private) AlgoOrder(req requests.AlgoOrder, ch ...chan *private.AlgoOrder) error {
-	m := okx.S2M(req)
+	m, err := okx.EncodeQuery(req)
+	if err != nil {
+		return err
+	}
 	if len(ch) > 0 {
 		c.aoCh = ch[0]
 	}
@@ -134,7 +169,10 @@ func (c *Private) AlgoOrder(req requests.AlgoOrder, ch ...chan *private.AlgoOrde
 //
 // https://www.okx.com/docs-v5/en/#websocket-api-private-channel-order-channel
 func (c *Private) UAlgoOrder(req requests.AlgoOrder, rCh ...bool) error {
-	m := okx.S2M(req)
+	m, err := okx.EncodeQuery(req)
+	if err != nil {
+		return err
+	}
 	if len(rCh) > 0 && rCh[0] {
 		c.aoCh = nil
 	}
@@ -146,7 +184,10 @@ func (c *Private) UAlgoOrder(req requests.AlgoOrder, rCh ...bool) error {
 //
 // https://www.okx.com/docs-v5/en/#websocket-api-private-channel-algo-orders-channel
 func (c *Private) AdvancedAlgoOrder(req requests.AlgoOrder, ch ...chan *private.AlgoOrder) error {
-	m := okx.S2M(req)
+	m, err := okx.EncodeQuery(req)
+	if err != nil {
+		return err
+	}
 	if len(ch) > 0 {
 		c.aaoCh = ch[0]
 	}
@@ -157,26 +198,481 @@ func (c *Private) AdvancedAlgoOrder(req requests.AlgoOrder, ch ...chan *private.
 //
 // https://www.okx.com/docs-v5/en/#websocket-api-private-channel-order-channel
 func (c *Private) UAdvancedAlgoOrder(req requests.AlgoOrder, rCh ...bool) error {
-	m := okx.S2M(req)
+	m, err := okx.EncodeQuery(req)
+	if err != nil {
+		return err
+	}
 	if len(rCh) > 0 && rCh[0] {
 		c.aaoCh = nil
 	}
 	return c.Unsubscribe(true, []okx.ChannelName{"algo-advance"}, m)
 }
 
+// SpreadOrders
+// Retrieve spread order updates.
+//
+// https://www.okx.com/docs-v5/en/#spread-trading-websocket-order-channel
+func (c *Private) SpreadOrders(req requests.SpreadOrders, ch ...chan *private.SpreadOrders) error {
+	m, err := okx.EncodeQuery(req)
+	if err != nil {
+		return err
+	}
+	if len(ch) > 0 {
+		c.soCh = ch[0]
+	}
+	return c.Subscribe(true, []okx.ChannelName{"sprd-orders"}, m)
+}
+
+// USpreadOrders
+//
+// https://www.okx.com/docs-v5/en/#spread-trading-websocket-order-channel
+func (c *Private) USpreadOrders(req requests.SpreadOrders, rCh ...bool) error {
+	m, err := okx.EncodeQuery(req)
+	if err != nil {
+		return err
+	}
+	if len(rCh) > 0 && rCh[0] {
+		c.soCh = nil
+	}
+	return c.Unsubscribe(true, []okx.ChannelName{"sprd-orders"}, m)
+}
+
+// SpreadTrades
+// Retrieve spread trade updates with the fills of each leg.
+//
+// https://www.okx.com/docs-v5/en/#spread-trading-websocket-trades-channel
+func (c *Private) SpreadTrades(req requests.SpreadTrades, ch ...chan *private.SpreadTrades) error {
+	m, err := okx.EncodeQuery(req)
+	if err != nil {
+		return err
+	}
+	if len(ch) > 0 {
+		c.strCh = ch[0]
+	}
+	return c.Subscribe(true, []okx.ChannelName{"sprd-trades"}, m)
+}
+
+// USpreadTrades
+//
+// https://www.okx.com/docs-v5/en/#spread-trading-websocket-trades-channel
+func (c *Private) USpreadTrades(req requests.SpreadTrades, rCh ...bool) error {
+	m, err := okx.EncodeQuery(req)
+	if err != nil {
+		return err
+	}
+	if len(rCh) > 0 && rCh[0] {
+		c.strCh = nil
+	}
+	return c.Unsubscribe(true, []okx.ChannelName{"sprd-trades"}, m)
+}
+
+// RFQs
+// Retrieve the RFQs sent or received by the user, pushed on creation and on every state change.
+//
+// https://www.okx.com/docs-v5/en/#block-trading-websocket-private-channel-rfqs-channel
+func (c *Private) RFQs(ch ...chan *private.RFQs) error {
+	m := make(map[string]string)
+	if len(ch) > 0 {
+		c.rfqCh = ch[0]
+	}
+	return c.Subscribe(true, []okx.ChannelName{"rfqs"}, m)
+}
+
+// URFQs
+//
+// https://www.okx.com/docs-v5/en/#block-trading-websocket-private-channel-rfqs-channel
+func (c *Private) URFQs(rCh ...bool) error {
+	m := make(map[string]string)
+	if len(rCh) > 0 && rCh[0] {
+		c.rfqCh = nil
+	}
+	return c.Unsubscribe(true, []okx.ChannelName{"rfqs"}, m)
+}
+
+// Quotes
+// Retrieve the quotes sent or received by the user, pushed on creation and on every state change.
+//
+// https://www.okx.com/docs-v5/en/#block-trading-websocket-private-channel-quotes-channel
+func (c *Private) Quotes(ch ...chan *private.Quotes) error {
+	m := make(map[string]string)
+	if len(ch) > 0 {
+		c.qCh = ch[0]
+	}
+	return c.Subscribe(true, []okx.ChannelName{"quotes"}, m)
+}
+
+// UQuotes
+//
+// https://www.okx.com/docs-v5/en/#block-trading-websocket-private-channel-quotes-channel
+func (c *Private) UQuotes(rCh ...bool) error {
+	m := make(map[string]string)
+	if len(rCh) > 0 && rCh[0] {
+		c.qCh = nil
+	}
+	return c.Unsubscribe(true, []okx.ChannelName{"quotes"}, m)
+}
+
+// BlockTrades
+// Retrieve the block trades the user is a counterparty to.
+//
+// https://www.okx.com/docs-v5/en/#block-trading-websocket-private-channel-structure-block-trades-channel
+func (c *Private) BlockTrades(ch ...chan *private.BlockTrades) error {
+	m := make(map[string]string)
+	if len(ch) > 0 {
+		c.sbtCh = ch[0]
+	}
+	return c.Subscribe(true, []okx.ChannelName{"struc-block-trades"}, m)
+}
+
+// UBlockTrades
+//
+// https://www.okx.com/docs-v5/en/#block-trading-websocket-private-channel-structure-block-trades-channel
+func (c *Private) UBlockTrades(rCh ...bool) error {
+	m := make(map[string]string)
+	if len(rCh) > 0 && rCh[0] {
+		c.sbtCh = nil
+	}
+	return c.Unsubscribe(true, []okx.ChannelName{"struc-block-trades"}, m)
+}
+
+// GridSpotOrders
+// Retrieve spot grid algo orders, pushed on every change and every 30 seconds.
+//
+// https://www.okx.com/docs-v5/en/#order-book-trading-grid-trading-ws-spot-grid-algo-orders-channel
+func (c *Private) GridSpotOrders(req requests.GridOrders, ch ...chan *private.GridOrders) error {
+	m, err := okx.EncodeQuery(req)
+	if err != nil {
+		return err
+	}
+	if len(ch) > 0 {
+		c.gsoCh = ch[0]
+	}
+	return c.Subscribe(true, []okx.ChannelName{"grid-orders-spot"}, m)
+}
+
+// UGridSpotOrders
+//
+// https://www.okx.com/docs-v5/en/#order-book-trading-grid-trading-ws-spot-grid-algo-orders-channel
+func (c *Private) UGridSpotOrders(req requests.GridOrders, rCh ...bool) error {
+	m, err := okx.EncodeQuery(req)
+	if err != nil {
+		return err
+	}
+	if len(rCh) > 0 && rCh[0] {
+		c.gsoCh = nil
+	}
+	return c.Unsubscribe(true, []okx.ChannelName{"grid-orders-spot"}, m)
+}
+
+// GridContractOrders
+// Retrieve contract grid algo orders, pushed on every change and every 30 seconds.
+//
+// https://www.okx.com/docs-v5/en/#order-book-trading-grid-trading-ws-contract-grid-algo-orders-channel
+func (c *Private) GridContractOrders(req requests.GridOrders, ch ...chan *private.GridOrders) error {
+	m, err := okx.EncodeQuery(req)
+	if err != nil {
+		return err
+	}
+	if len(ch) > 0 {
+		c.gcoCh = ch[0]
+	}
+	return c.Subscribe(true, []okx.ChannelName{"grid-orders-contract"}, m)
+}
+
+// UGridContractOrders
+//
+// https://www.okx.com/docs-v5/en/#order-book-trading-grid-trading-ws-contract-grid-algo-orders-channel
+func (c *Private) UGridContractOrders(req requests.GridOrders, rCh ...bool) error {
+	m, err := okx.EncodeQuery(req)
+	if err != nil {
+		return err
+	}
+	if len(rCh) > 0 && rCh[0] {
+		c.gcoCh = nil
+	}
+	return c.Unsubscribe(true, []okx.ChannelName{"grid-orders-contract"}, m)
+}
+
+// GridPositions
+// Retrieve the positions of a contract grid algo order.
+//
+// https://www.okx.com/docs-v5/en/#order-book-trading-grid-trading-ws-grid-positions-channel
+func (c *Private) GridPositions(req requests.GridPositions, ch ...chan *private.GridPositions) error {
+	m, err := okx.EncodeQuery(req)
+	if err != nil {
+		return err
+	}
+	if len(ch) > 0 {
+		c.gpCh = ch[0]
+	}
+	return c.Subscribe(true, []okx.ChannelName{"grid-positions"}, m)
+}
+
+// UGridPositions
+//
+// https://www.okx.com/docs-v5/en/#order-book-trading-grid-trading-ws-grid-positions-channel
+func (c *Private) UGridPositions(req requests.GridPositions, rCh ...bool) error {
+	m, err := okx.EncodeQuery(req)
+	if err != nil {
+		return err
+	}
+	if len(rCh) > 0 && rCh[0] {
+		c.gpCh = nil
+	}
+	return c.Unsubscribe(true, []okx.ChannelName{"grid-positions"}, m)
+}
+
+// GridSubOrders
+// Retrieve the sub orders of a grid algo order.
+//
+// https://www.okx.com/docs-v5/en/#order-book-trading-grid-trading-ws-grid-sub-orders-channel
+func (c *Private) GridSubOrders(req requests.GridSubOrders, ch ...chan *private.GridSubOrders) error {
+	m, err := okx.EncodeQuery(req)
+	if err != nil {
+		return err
+	}
+	if len(ch) > 0 {
+		c.gsubCh = ch[0]
+	}
+	return c.Subscribe(true, []okx.ChannelName{"grid-sub-orders"}, m)
+}
+
+// UGridSubOrders
+//
+// https://www.okx.com/docs-v5/en/#order-book-trading-grid-trading-ws-grid-sub-orders-channel
+func (c *Private) UGridSubOrders(req requests.GridSubOrders, rCh ...bool) error {
+	m, err := okx.EncodeQuery(req)
+	if err != nil {
+		return err
+	}
+	if len(rCh) > 0 && rCh[0] {
+		c.gsubCh = nil
+	}
+	return c.Unsubscribe(true, []okx.ChannelName{"grid-sub-orders"}, m)
+}
+
+// CopyTradingNotification
+// Retrieve the notifications of the lead trader, such as lead positions opened or closed by the system.
+//
+// https://www.okx.com/docs-v5/en/#order-book-trading-copy-trading-ws-copy-trading-notification-channel
+func (c *Private) CopyTradingNotification(req requests.CopyTradingNotification, ch ...chan *private.CopyTradingNotification) error {
+	m, err := okx.EncodeQuery(req)
+	if err != nil {
+		return err
+	}
+	if len(ch) > 0 {
+		c.ctnCh = ch[0]
+	}
+	return c.Subscribe(true, []okx.ChannelName{"copytrading-notification"}, m)
+}
+
+// UCopyTradingNotification
+//
+// https://www.okx.com/docs-v5/en/#order-book-trading-copy-trading-ws-copy-trading-notification-channel
+func (c *Private) UCopyTradingNotification(req requests.CopyTradingNotification, rCh ...bool) error {
+	m, err := okx.EncodeQuery(req)
+	if err != nil {
+		return err
+	}
+	if len(rCh) > 0 && rCh[0] {
+		c.ctnCh = nil
+	}
+	return c.Unsubscribe(true, []okx.ChannelName{"copytrading-notification"}, m)
+}
+
+// OnAccount registers fn for the account channel, subscribing to it if needed
+//
+// https://www.okx.com/docs-v5/en/#websocket-api-private-channel-account-channel
+func (c *Private) OnAccount(req requests.Account, fn func(*private.Account)) (*Handler, error) {
+	m, err := okx.EncodeQuery(req)
+	if err != nil {
+		return nil, err
+	}
+	m["channel"] = "account"
+	return c.on(true, m, func(e interface{}) { fn(e.(*private.Account)) })
+}
+
+// OnPosition registers fn for the positions channel, subscribing to it if needed
+//
+// https://www.okx.com/docs-v5/en/#websocket-api-private-channel-positions-channel
+func (c *Private) OnPosition(req requests.Position, fn func(*private.Position)) (*Handler, error) {
+	m, err := okx.EncodeQuery(req)
+	if err != nil {
+		return nil, err
+	}
+	m["channel"] = "positions"
+	return c.on(true, m, func(e interface{}) { fn(e.(*private.Position)) })
+}
+
+// OnBalanceAndPosition registers fn for the balance_and_position channel, subscribing to it if needed
+//
+// https://www.okx.com/docs-v5/en/#websocket-api-private-channel-balance-and-position-channel
+func (c *Private) OnBalanceAndPosition(fn func(*private.BalanceAndPosition)) (*Handler, error) {
+	m := map[string]string{"channel": "balance_and_position"}
+	return c.on(true, m, func(e interface{}) { fn(e.(*private.BalanceAndPosition)) })
+}
+
+// OnOrder registers fn for the orders channel filtered by req, subscribing to it if needed.
+// The updates of the orders reach fn in the order OKX pushed them, see Handler.
+//
+// https://www.okx.com/docs-v5/en/#websocket-api-private-channel-order-channel
+func (c *Private) OnOrder(req requests.Order, fn func(*private.Order)) (*Handler, error) {
+	m, err := okx.EncodeQuery(req)
+	if err != nil {
+		return nil, err
+	}
+	m["channel"] = "orders"
+	return c.on(true, m, func(e interface{}) { fn(e.(*private.Order)) })
+}
+
+// OnAlgoOrder registers fn for the orders-algo channel filtered by req, subscribing to it if needed
+//
+// https://www.okx.com/docs-v5/en/#websocket-api-private-channel-algo-orders-channel
+func (c *Private) OnAlgoOrder(req requests.AlgoOrder, fn func(*private.AlgoOrder)) (*Handler, error) {
+	m, err := okx.EncodeQuery(req)
+	if err != nil {
+		return nil, err
+	}
+	m["channel"] = "orders-algo"
+	return c.on(true, m, func(e interface{}) { fn(e.(*private.AlgoOrder)) })
+}
+
+// OnAdvancedAlgoOrder registers fn for the algo-advance channel filtered by req, subscribing to it if needed
+//
+// https://www.okx.com/docs-v5/en/#websocket-api-private-channel-algo-orders-channel
+func (c *Private) OnAdvancedAlgoOrder(req requests.AlgoOrder, fn func(*private.AlgoOrder)) (*Handler, error) {
+	m, err := okx.EncodeQuery(req)
+	if err != nil {
+		return nil, err
+	}
+	m["channel"] = "algo-advance"
+	return c.on(true, m, func(e interface{}) { fn(e.(*private.AlgoOrder)) })
+}
+
+// OnSpreadOrders registers fn for the sprd-orders channel, subscribing to it if needed
+//
+// https://www.okx.com/docs-v5/en/#spread-trading-websocket-order-channel
+func (c *Private) OnSpreadOrders(req requests.SpreadOrders, fn func(*private.SpreadOrders)) (*Handler, error) {
+	m, err := okx.EncodeQuery(req)
+	if err != nil {
+		return nil, err
+	}
+	m["channel"] = "sprd-orders"
+	return c.on(true, m, func(e interface{}) { fn(e.(*private.SpreadOrders)) })
+}
+
+// OnSpreadTrades registers fn for the sprd-trades channel, subscribing to it if needed
+//
+// https://www.okx.com/docs-v5/en/#spread-trading-websocket-trades-channel
+func (c *Private) OnSpreadTrades(req requests.SpreadTrades, fn func(*private.SpreadTrades)) (*Handler, error) {
+	m, err := okx.EncodeQuery(req)
+	if err != nil {
+		return nil, err
+	}
+	m["channel"] = "sprd-trades"
+	return c.on(true, m, func(e interface{}) { fn(e.(*private.SpreadTrades)) })
+}
+
+// OnRFQs registers fn for the rfqs channel, subscribing to it if needed
+//
+// https://www.okx.com/docs-v5/en/#block-trading-websocket-private-channel-rfqs-channel
+func (c *Private) OnRFQs(fn func(*private.RFQs)) (*Handler, error) {
+	m := map[string]string{"channel": "rfqs"}
+	return c.on(true, m, func(e interface{}) { fn(e.(*private.RFQs)) })
+}
+
+// OnQuotes registers fn for the quotes channel, subscribing to it if needed
+//
+// https://www.okx.com/docs-v5/en/#block-trading-websocket-private-channel-quotes-channel
+func (c *Private) OnQuotes(fn func(*private.Quotes)) (*Handler, error) {
+	m := map[string]string{"channel": "quotes"}
+	return c.on(true, m, func(e interface{}) { fn(e.(*private.Quotes)) })
+}
+
+// OnBlockTrades registers fn for the struc-block-trades channel, subscribing to it if needed
+//
+// https://www.okx.com/docs-v5/en/#block-trading-websocket-private-channel-structure-block-trades-channel
+func (c *Private) OnBlockTrades(fn func(*private.BlockTrades)) (*Handler, error) {
+	m := map[string]string{"channel": "struc-block-trades"}
+	return c.on(true, m, func(e interface{}) { fn(e.(*private.BlockTrades)) })
+}
+
+// OnGridSpotOrders registers fn for the grid-orders-spot channel, subscribing to it if needed
+//
+// https://www.okx.com/docs-v5/en/#order-book-trading-grid-trading-ws-spot-grid-algo-orders-channel
+func (c *Private) OnGridSpotOrders(req requests.GridOrders, fn func(*private.GridOrders)) (*Handler, error) {
+	m, err := okx.EncodeQuery(req)
+	if err != nil {
+		return nil, err
+	}
+	m["channel"] = "grid-orders-spot"
+	return c.on(true, m, func(e interface{}) { fn(e.(*private.GridOrders)) })
+}
+
+// OnGridContractOrders registers fn for the grid-orders-contract channel, subscribing to it if needed
+//
+// https://www.okx.com/docs-v5/en/#order-book-trading-grid-trading-ws-contract-grid-algo-orders-channel
+func (c *Private) OnGridContractOrders(req requests.GridOrders, fn func(*private.GridOrders)) (*Handler, error) {
+	m, err := okx.EncodeQuery(req)
+	if err != nil {
+		return nil, err
+	}
+	m["channel"] = "grid-orders-contract"
+	return c.on(true, m, func(e interface{}) { fn(e.(*private.GridOrders)) })
+}
+
+// OnGridPositions registers fn for the grid-positions channel, subscribing to it if needed
+//
+// https://www.okx.com/docs-v5/en/#order-book-trading-grid-trading-ws-grid-positions-channel
+func (c *Private) OnGridPositions(req requests.GridPositions, fn func(*private.GridPositions)) (*Handler, error) {
+	m, err := okx.EncodeQuery(req)
+	if err != nil {
+		return nil, err
+	}
+	m["channel"] = "grid-positions"
+	return c.on(true, m, func(e interface{}) { fn(e.(*private.GridPositions)) })
+}
+
+// OnGridSubOrders registers fn for the grid-sub-orders channel, subscribing to it if needed
+//
+// https://www.okx.com/docs-v5/en/#order-book-trading-grid-trading-ws-grid-sub-orders-channel
+func (c *Private) OnGridSubOrders(req requests.GridSubOrders, fn func(*private.GridSubOrders)) (*Handler, error) {
+	m, err := okx.EncodeQuery(req)
+	if err != nil {
+		return nil, err
+	}
+	m["channel"] = "grid-sub-orders"
+	return c.on(true, m, func(e interface{}) { fn(e.(*private.GridSubOrders)) })
+}
+
+// OnCopyTradingNotification registers fn for the copytrading-notification channel, subscribing to it if needed
+//
+// https://www.okx.com/docs-v5/en/#order-book-trading-copy-trading-ws-copy-trading-notification-channel
+func (c *Private) OnCopyTradingNotification(req requests.CopyTradingNotification, fn func(*private.CopyTradingNotification)) (*Handler, error) {
+	m, err := okx.EncodeQuery(req)
+	if err != nil {
+		return nil, err
+	}
+	m["channel"] = "copytrading-notification"
+	return c.on(true, m, func(e interface{}) { fn(e.(*private.CopyTradingNotification)) })
+}
+
 func (c *Private) Process(data []byte, e *events.Basic) bool {
 	if e.Event == "" && e.Arg != nil && e.Data != nil && len(e.Data) > 0 {
 		ch, ok := e.Arg.Get("channel")
 		if !ok {
 			return false
 		}
+		chName := fmt.Sprint(ch)
 		switch ch {
 		case "account":
 			e := private.Account{}
 			err := json.Unmarshal(data, &e)
 			if err != nil {
-				return false
+				c.decodeError(chName, data, err)
+				return true
 			}
+			c.dispatch(chName, e.Arg, &e)
 			go func() {
 				if c.aCh != nil {
 					c.aCh <- &e
@@ -187,8 +683,10 @@ func (c *Private) Process(data []byte, e *events.Basic) bool {
 			e := private.Position{}
 			err := json.Unmarshal(data, &e)
 			if err != nil {
-				return false
+				c.decodeError(chName, data, err)
+				return true
 			}
+			c.dispatch(chName, e.Arg, &e)
 			go func() {
 				if c.pCh != nil {
 					c.pCh <- &e
@@ -199,8 +697,10 @@ func (c *Private) Process(data []byte, e *events.Basic) bool {
 			e := private.BalanceAndPosition{}
 			err := json.Unmarshal(data, &e)
 			if err != nil {
-				return false
+				c.decodeError(chName, data, err)
+				return true
 			}
+			c.dispatch(chName, e.Arg, &e)
 			go func() {
 				if c.bnpCh != nil {
 					c.bnpCh <- &e
@@ -211,8 +711,10 @@ func (c *Private) Process(data []byte, e *events.Basic) bool {
 			e := private.Order{}
 			err := json.Unmarshal(data, &e)
 			if err != nil {
-				return false
+				c.decodeError(chName, data, err)
+				return true
 			}
+			c.dispatch(chName, e.Arg, &e)
 			go func() {
 				if c.oCh != nil {
 					c.oCh <- &e
@@ -223,8 +725,10 @@ func (c *Private) Process(data []byte, e *events.Basic) bool {
 			e := private.AlgoOrder{}
 			err := json.Unmarshal(data, &e)
 			if err != nil {
-				return false
+				c.decodeError(chName, data, err)
+				return true
 			}
+			c.dispatch(chName, e.Arg, &e)
 			go func() {
 				if c.aoCh != nil {
 					c.aoCh <- &e
@@ -235,14 +739,156 @@ func (c *Private) Process(data []byte, e *events.Basic) bool {
 			e := private.AlgoOrder{}
 			err := json.Unmarshal(data, &e)
 			if err != nil {
-				return false
+				c.decodeError(chName, data, err)
+				return true
 			}
+			c.dispatch(chName, e.Arg, &e)
 			go func() {
 				if c.aaoCh != nil {
 					c.aaoCh <- &e
 				}
 			}()
 			return true
+		case "sprd-orders":
+			e := private.SpreadOrders{}
+			err := json.Unmarshal(data, &e)
+			if err != nil {
+				c.decodeError(chName, data, err)
+				return true
+			}
+			c.dispatch(chName, e.Arg, &e)
+			go func() {
+				if c.soCh != nil {
+					c.soCh <- &e
+				}
+			}()
+			return true
+		case "sprd-trades":
+			e := private.SpreadTrades{}
+			err := json.Unmarshal(data, &e)
+			if err != nil {
+				c.decodeError(chName, data, err)
+				return true
+			}
+			c.dispatch(chName, e.Arg, &e)
+			go func() {
+				if c.strCh != nil {
+					c.strCh <- &e
+				}
+			}()
+			return true
+		case "rfqs":
+			e := private.RFQs{}
+			err := json.Unmarshal(data, &e)
+			if err != nil {
+				c.decodeError(chName, data, err)
+				return true
+			}
+			c.dispatch(chName, e.Arg, &e)
+			go func() {
+				if c.rfqCh != nil {
+					c.rfqCh <- &e
+				}
+			}()
+			return true
+		case "quotes":
+			e := private.Quotes{}
+			err := json.Unmarshal(data, &e)
+			if err != nil {
+				c.decodeError(chName, data, err)
+				return true
+			}
+			c.dispatch(chName, e.Arg, &e)
+			go func() {
+				if c.qCh != nil {
+					c.qCh <- &e
+				}
+			}()
+			return true
+		case "struc-block-trades":
+			e := private.BlockTrades{}
+			err := json.Unmarshal(data, &e)
+			if err != nil {
+				c.decodeError(chName, data, err)
+				return true
+			}
+			c.dispatch(chName, e.Arg, &e)
+			go func() {
+				if c.sbtCh != nil {
+					c.sbtCh <- &e
+				}
+			}()
+			return true
+		case "grid-orders-spot":
+			e := private.GridOrders{}
+			err := json.Unmarshal(data, &e)
+			if err != nil {
+				c.decodeError(chName, data, err)
+				return true
+			}
+			c.dispatch(chName, e.Arg, &e)
+			go func() {
+				if c.gsoCh != nil {
+					c.gsoCh <- &e
+				}
+			}()
+			return true
+		case "grid-orders-contract":
+			e := private.GridOrders{}
+			err := json.Unmarshal(data, &e)
+			if err != nil {
+				c.decodeError(chName, data, err)
+				return true
+			}
+			c.dispatch(chName, e.Arg, &e)
+			go func() {
+				if c.gcoCh != nil {
+					c.gcoCh <- &e
+				}
+			}()
+			return true
+		case "grid-positions":
+			e := private.GridPositions{}
+			err := json.Unmarshal(data, &e)
+			if err != nil {
+				c.decodeError(chName, data, err)
+				return true
+			}
+			c.dispatch(chName, e.Arg, &e)
+			go func() {
+				if c.gpCh != nil {
+					c.gpCh <- &e
+				}
+			}()
+			return true
+		case "grid-sub-orders":
+			e := private.GridSubOrders{}
+			err := json.Unmarshal(data, &e)
+			if err != nil {
+				c.decodeError(chName, data, err)
+				return true
+			}
+			c.dispatch(chName, e.Arg, &e)
+			go func() {
+				if c.gsubCh != nil {
+					c.gsubCh <- &e
+				}
+			}()
+			return true
+		case "copytrading-notification":
+			e := private.CopyTradingNotification{}
+			err := json.Unmarshal(data, &e)
+			if err != nil {
+				c.decodeError(chName, data, err)
+				return true
+			}
+			c.dispatch(chName, e.Arg, &e)
+			go func() {
+				if c.ctnCh != nil {
+					c.ctnCh <- &e
+				}
+			}()
+			return true
 		}
 	}
 	return false
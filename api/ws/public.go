@@ -13,6 +13,8 @@ import (
 
 // Public
 //
+// The spread channels are served on the business websocket url, see okx.BusinessServer.
+//
 // https://www.okx.com/docs-v5/en/#websocket-api-public-channels
 type Public struct {
 	*ClientWs
@@ -30,6 +32,9 @@ type Public struct {
 	frCh   chan *public.FundingRate
 	icCh   chan *public.IndexCandlesticks
 	itCh   chan *public.IndexTickers
+	sobCh  chan *public.SpreadOrderBook
+	sptCh  chan *public.SpreadPublicTrades
+	stCh   chan *public.SpreadTickers
 }
 
 // NewPublic returns a pointer to a fresh Public
@@ -42,7 +47,10 @@ func NewPublic(c *ClientWs) *Public {
 //
 // https://www.okx.com/docs-v5/en/#websocket-api-public-channels-instruments-channel
 func (c *Public) Instruments(req requests.Instruments, ch ...chan *public.Instruments) error {
-	m := okx.S2M(req)
+	m, err := okx.EncodeQuery(req)
+	if err != nil {
+		return err
+	}
 	if len(ch) > 0 {
 		c.iCh = ch[0]
 	}
@@ -53,7 +61,10 @@ func (c *Public) Instruments(req requests.Instruments, ch ...chan *public.Instru
 //
 // https://www.okx.com/docs-v5/en/#websocket-api-public-channels-instruments-channel
 func (c *Public) UInstruments(req requests.Instruments, rCh ...bool) error {
-	m := okx.S2M(req)
+	m, err := okx.EncodeQuery(req)
+	if err != nil {
+		return err
+	}
 	if len(rCh) > 0 && rCh[0] {
 		c.iCh = nil
 	}
@@ -65,7 +76,10 @@ func (c *Public) UInstruments(req requests.Instruments, rCh ...bool) error {
 //
 // https://www.okx.com/docs-v5/en/#websocket-api-public-channels-tickers-channel
 func (c *Public) Tickers(req requests.Tickers, ch ...chan *public.Tickers) error {
-	m := okx.S2M(req)
+	m, err := okx.EncodeQuery(req)
+	if err != nil {
+		return err
+	}
 	if len(ch) > 0 {
 		c.tCh = ch[0]
 	}
@@ -76,7 +90,10 @@ func (c *Public) Tickers(req requests.Tickers, ch ...chan *public.Tickers) error
 //
 // https://www.okx.com/docs-v5/en/#websocket-api-public-channels-tickers-channel
 func (c *Public) UTickers(req requests.Tickers, rCh ...bool) error {
-	m := okx.S2M(req)
+	m, err := okx.EncodeQuery(req)
+	if err != nil {
+		return err
+	}
 	if len(rCh) > 0 && rCh[0] {
 		c.tCh = nil
 	}
@@ -88,7 +105,10 @@ func (c *Public) UTickers(req requests.Tickers, rCh ...bool) error {
 //
 // https://www.okx.com/docs-v5/en/#websocket-api-public-channels-open-interest-channel
 func (c *Public) OpenInterest(req requests.OpenInterest, ch ...chan *public.OpenInterest) error {
-	m := okx.S2M(req)
+	m, err := okx.EncodeQuery(req)
+	if err != nil {
+		return err
+	}
 	if len(ch) > 0 {
 		c.oiCh = ch[0]
 	}
@@ -99,7 +119,10 @@ func (c *Public) OpenInterest(req requests.OpenInterest, ch ...chan *public.Open
 //
 // https://www.okx.com/docs-v5/en/#websocket-api-public-channels-open-interest-channel
 func (c *Public) UOpenInterest(req requests.OpenInterest, rCh ...bool) error {
-	m := okx.S2M(req)
+	m, err := okx.EncodeQuery(req)
+	if err != nil {
+		return err
+	}
 	if len(rCh) > 0 && rCh[0] {
 		c.oiCh = nil
 	}
@@ -111,7 +134,10 @@ func (c *Public) UOpenInterest(req requests.OpenInterest, rCh ...bool) error {
 //
 // https://www.okx.com/docs-v5/en/#websocket-api-public-channels-candlesticks-channel
 func (c *Public) Candlesticks(req requests.Candlesticks, ch ...chan *public.Candlesticks) error {
-	m := okx.S2M(req)
+	m, err := okx.EncodeQuery(req)
+	if err != nil {
+		return err
+	}
 	if len(ch) > 0 {
 		c.cCh = ch[0]
 	}
@@ -122,7 +148,10 @@ func (c *Public) Candlesticks(req requests.Candlesticks, ch ...chan *public.Cand
 //
 // https://www.okx.com/docs-v5/en/#websocket-api-public-channels-candlesticks-channel
 func (c *Public) UCandlesticks(req requests.Candlesticks, rCh ...bool) error {
-	m := okx.S2M(req)
+	m, err := okx.EncodeQuery(req)
+	if err != nil {
+		return err
+	}
 	if len(rCh) > 0 && rCh[0] {
 		c.cCh = nil
 	}
@@ -134,7 +163,10 @@ func (c *Public) UCandlesticks(req requests.Candlesticks, rCh ...bool) error {
 //
 // https://www.okx.com/docs-v5/en/#websocket-api-public-channels-trades-channel
 func (c *Public) Trades(req requests.Trades, ch ...chan *public.Trades) error {
-	m := okx.S2M(req)
+	m, err := okx.EncodeQuery(req)
+	if err != nil {
+		return err
+	}
 	if len(ch) > 0 {
 		c.trCh = ch[0]
 	}
@@ -145,7 +177,10 @@ func (c *Public) Trades(req requests.Trades, ch ...chan *public.Trades) error {
 //
 // https://www.okx.com/docs-v5/en/#websocket-api-public-channels-trades-channel
 func (c *Public) UTrades(req requests.Trades, rCh ...bool) error {
-	m := okx.S2M(req)
+	m, err := okx.EncodeQuery(req)
+	if err != nil {
+		return err
+	}
 	if len(rCh) > 0 && rCh[0] {
 		c.trCh = nil
 	}
@@ -159,7 +194,10 @@ func (c *Public) UTrades(req requests.Trades, rCh ...bool) error {
 //
 // https://www.okx.com/docs-v5/en/#websocket-api-public-channels-estimated-delivery-exercise-price-channel
 func (c *Public) EstimatedDeliveryExercisePrice(req requests.EstimatedDeliveryExercisePrice, ch ...chan *public.EstimatedDeliveryExercisePrice) error {
-	m := okx.S2M(req)
+	m, err := okx.EncodeQuery(req)
+	if err != nil {
+		return err
+	}
 	if len(ch) > 0 {
 		c.edepCh = ch[0]
 	}
@@ -170,7 +208,10 @@ func (c *Public) EstimatedDeliveryExercisePrice(req requests.EstimatedDeliveryEx
 //
 // https://www.okx.com/docs-v5/en/#websocket-api-public-channels-estimated-delivery-exercise-price-channel
 func (c *Public) UEstimatedDeliveryExercisePrice(req requests.EstimatedDeliveryExercisePrice, rCh ...bool) error {
-	m := okx.S2M(req)
+	m, err := okx.EncodeQuery(req)
+	if err != nil {
+		return err
+	}
 	if len(rCh) > 0 && rCh[0] {
 		c.edepCh = nil
 	}
@@ -182,7 +223,10 @@ func (c *Public) UEstimatedDeliveryExercisePrice(req requests.EstimatedDeliveryE
 //
 // https://www.okx.com/docs-v5/en/#websocket-api-public-channels-mark-price-channel
 func (c *Public) MarkPrice(req requests.MarkPrice, ch ...chan *public.MarkPrice) error {
-	m := okx.S2M(req)
+	m, err := okx.EncodeQuery(req)
+	if err != nil {
+		return err
+	}
 	if len(ch) > 0 {
 		c.mpCh = ch[0]
 	}
@@ -193,7 +237,10 @@ func (c *Public) MarkPrice(req requests.MarkPrice, ch ...chan *public.MarkPrice)
 //
 // https://www.okx.com/docs-v5/en/#websocket-api-public-channels-mark-price-channel
 func (c *Public) UMarkPrice(req requests.MarkPrice, rCh ...bool) error {
-	m := okx.S2M(req)
+	m, err := okx.EncodeQuery(req)
+	if err != nil {
+		return err
+	}
 	if len(rCh) > 0 && rCh[0] {
 		c.mpCh = nil
 	}
@@ -205,7 +252,10 @@ func (c *Public) UMarkPrice(req requests.MarkPrice, rCh ...bool) error {
 //
 // https://www.okx.com/docs-v5/en/#websocket-api-public-channels-mark-price-candlesticks-channel
 func (c *Public) MarkPriceCandlesticks(req requests.MarkPriceCandlesticks, ch ...chan *public.MarkPriceCandlesticks) error {
-	m := okx.S2M(req)
+	m, err := okx.EncodeQuery(req)
+	if err != nil {
+		return err
+	}
 	m["channel"] = "mark-price-" + m["channel"]
 	if len(ch) > 0 {
 		c.mpcCh = ch[0]
@@ -217,7 +267,10 @@ func (c *Public) MarkPriceCandlesticks(req requests.MarkPriceCandlesticks, ch ..
 //
 // https://www.okx.com/docs-v5/en/#websocket-api-public-channels-mark-price-candlesticks-channel
 func (c *Public) UMarkPriceCandlesticks(req requests.MarkPriceCandlesticks, rCh ...bool) error {
-	m := okx.S2M(req)
+	m, err := okx.EncodeQuery(req)
+	if err != nil {
+		return err
+	}
 	m["channel"] = "mark-price-" + m["channel"]
 	if len(rCh) > 0 && rCh[0] {
 		c.mpcCh = nil
@@ -230,7 +283,10 @@ func (c *Public) UMarkPriceCandlesticks(req requests.MarkPriceCandlesticks, rCh
 //
 // https://www.okx.com/docs-v5/en/#websocket-api-public-channels-price-limit-channel
 func (c *Public) PriceLimit(req requests.PriceLimit, ch ...chan *public.PriceLimit) error {
-	m := okx.S2M(req)
+	m, err := okx.EncodeQuery(req)
+	if err != nil {
+		return err
+	}
 	if len(ch) > 0 {
 		c.plCh = ch[0]
 	}
@@ -241,7 +297,10 @@ func (c *Public) PriceLimit(req requests.PriceLimit, ch ...chan *public.PriceLim
 //
 // https://www.okx.com/docs-v5/en/#websocket-api-public-channels-price-limit-channel
 func (c *Public) UPriceLimit(req requests.PriceLimit, rCh ...bool) error {
-	m := okx.S2M(req)
+	m, err := okx.EncodeQuery(req)
+	if err != nil {
+		return err
+	}
 	if len(rCh) > 0 && rCh[0] {
 		c.plCh = nil
 	}
@@ -255,7 +314,10 @@ func (c *Public) UPriceLimit(req requests.PriceLimit, rCh ...bool) error {
 //
 // https://www.okx.com/docs-v5/zh/#order-book-trading-market-data-ws-order-book-channel
 func (c *Public) OrderBook(req requests.OrderBook, ch ...chan *public.OrderBook) error {
-	m := okx.S2M(req)
+	m, err := okx.EncodeQuery(req)
+	if err != nil {
+		return err
+	}
 	if len(ch) > 0 {
 		c.obCh = ch[0]
 	}
@@ -266,7 +328,10 @@ func (c *Public) OrderBook(req requests.OrderBook, ch ...chan *public.OrderBook)
 //
 // https://www.okx.com/docs-v5/en/#websocket-api-public-channels-order-book-channel
 func (c *Public) UOrderBook(req requests.OrderBook, rCh ...bool) error {
-	m := okx.S2M(req)
+	m, err := okx.EncodeQuery(req)
+	if err != nil {
+		return err
+	}
 	if len(rCh) > 0 && rCh[0] {
 		c.obCh = nil
 	}
@@ -278,7 +343,10 @@ func (c *Public) UOrderBook(req requests.OrderBook, rCh ...bool) error {
 //
 // https://www.okx.com/docs-v5/en/#websocket-api-public-channels-option-summary-channel
 func (c *Public) OPTIONSummary(req requests.OPTIONSummary, ch ...chan *public.OPTIONSummary) error {
-	m := okx.S2M(req)
+	m, err := okx.EncodeQuery(req)
+	if err != nil {
+		return err
+	}
 	if len(ch) > 0 {
 		c.osCh = ch[0]
 	}
@@ -289,7 +357,10 @@ func (c *Public) OPTIONSummary(req requests.OPTIONSummary, ch ...chan *public.OP
 //
 // https://www.okx.com/docs-v5/en/#websocket-api-public-channels-option-summary-channel
 func (c *Public) UOPTIONSummary(req requests.OPTIONSummary, rCh ...bool) error {
-	m := okx.S2M(req)
+	m, err := okx.EncodeQuery(req)
+	if err != nil {
+		return err
+	}
 	if len(rCh) > 0 && rCh[0] {
 		c.osCh = nil
 	}
@@ -301,7 +372,10 @@ func (c *Public) UOPTIONSummary(req requests.OPTIONSummary, rCh ...bool) error {
 //
 // https://www.okx.com/docs-v5/en/#websocket-api-public-channels-funding-rate-channel
 func (c *Public) FundingRate(req requests.FundingRate, ch ...chan *public.FundingRate) error {
-	m := okx.S2M(req)
+	m, err := okx.EncodeQuery(req)
+	if err != nil {
+		return err
+	}
 	if len(ch) > 0 {
 		c.frCh = ch[0]
 	}
@@ -312,7 +386,10 @@ func (c *Public) FundingRate(req requests.FundingRate, ch ...chan *public.Fundin
 //
 // https://www.okx.com/docs-v5/en/#websocket-api-public-channels-funding-rate-channel
 func (c *Public) UFundingRate(req requests.FundingRate, rCh ...bool) error {
-	m := okx.S2M(req)
+	m, err := okx.EncodeQuery(req)
+	if err != nil {
+		return err
+	}
 	if len(rCh) > 0 && rCh[0] {
 		c.frCh = nil
 	}
@@ -324,7 +401,10 @@ func (c *Public) UFundingRate(req requests.FundingRate, rCh ...bool) error {
 //
 // https://www.okx.com/docs-v5/en/#websocket-api-public-channels-index-candlesticks-channel
 func (c *Public) IndexCandlesticks(req requests.IndexCandlesticks, ch ...chan *public.IndexCandlesticks) error {
-	m := okx.S2M(req)
+	m, err := okx.EncodeQuery(req)
+	if err != nil {
+		return err
+	}
 	m["channel"] = req.Channel
 	if len(ch) > 0 {
 		c.icCh = ch[0]
@@ -336,7 +416,10 @@ func (c *Public) IndexCandlesticks(req requests.IndexCandlesticks, ch ...chan *p
 //
 // https://www.okx.com/docs-v5/en/#websocket-api-public-channels-index-candlesticks-channel
 func (c *Public) UIndexCandlesticks(req requests.IndexCandlesticks, rCh ...bool) error {
-	m := okx.S2M(req)
+	m, err := okx.EncodeQuery(req)
+	if err != nil {
+		return err
+	}
 	m["channel"] = req.Channel
 	if len(rCh) > 0 && rCh[0] {
 		c.icCh = nil
@@ -349,7 +432,10 @@ func (c *Public) UIndexCandlesticks(req requests.IndexCandlesticks, rCh ...bool)
 //
 // https://www.okx.com/docs-v5/en/#websocket-api-public-channels-index-tickers-channel
 func (c *Public) IndexTickers(req requests.IndexTickers, ch ...chan *public.IndexTickers) error {
-	m := okx.S2M(req)
+	m, err := okx.EncodeQuery(req)
+	if err != nil {
+		return err
+	}
 	if len(ch) > 0 {
 		c.itCh = ch[0]
 	}
@@ -360,26 +446,322 @@ func (c *Public) IndexTickers(req requests.IndexTickers, ch ...chan *public.Inde
 //
 // https://www.okx.com/docs-v5/en/#websocket-api-public-channels-index-tickers-channel
 func (c *Public) UIndexTickers(req requests.IndexTickers, rCh ...bool) error {
-	m := okx.S2M(req)
+	m, err := okx.EncodeQuery(req)
+	if err != nil {
+		return err
+	}
 	if len(rCh) > 0 && rCh[0] {
 		c.itCh = nil
 	}
 	return c.Unsubscribe(false, []okx.ChannelName{"index-tickers"}, m)
 }
 
+// SpreadOrderBook
+// Retrieve the 5 best bid and ask levels of a spread order book, pushed every 100 ms when it changes.
+//
+// https://www.okx.com/docs-v5/en/#spread-trading-websocket-order-book-channel
+func (c *Public) SpreadOrderBook(req requests.SpreadOrderBook, ch ...chan *public.SpreadOrderBook) error {
+	m, err := okx.EncodeQuery(req)
+	if err != nil {
+		return err
+	}
+	if len(ch) > 0 {
+		c.sobCh = ch[0]
+	}
+	return c.Subscribe(false, []okx.ChannelName{"sprd-books5"}, m)
+}
+
+// USpreadOrderBook
+//
+// https://www.okx.com/docs-v5/en/#spread-trading-websocket-order-book-channel
+func (c *Public) USpreadOrderBook(req requests.SpreadOrderBook, rCh ...bool) error {
+	m, err := okx.EncodeQuery(req)
+	if err != nil {
+		return err
+	}
+	if len(rCh) > 0 && rCh[0] {
+		c.sobCh = nil
+	}
+	return c.Unsubscribe(false, []okx.ChannelName{"sprd-books5"}, m)
+}
+
+// SpreadPublicTrades
+// Retrieve the recent trades of a spread.
+//
+// https://www.okx.com/docs-v5/en/#spread-trading-websocket-public-trades-channel
+func (c *Public) SpreadPublicTrades(req requests.SpreadPublicTrades, ch ...chan *public.SpreadPublicTrades) error {
+	m, err := okx.EncodeQuery(req)
+	if err != nil {
+		return err
+	}
+	if len(ch) > 0 {
+		c.sptCh = ch[0]
+	}
+	return c.Subscribe(false, []okx.ChannelName{"sprd-public-trades"}, m)
+}
+
+// USpreadPublicTrades
+//
+// https://www.okx.com/docs-v5/en/#spread-trading-websocket-public-trades-channel
+func (c *Public) USpreadPublicTrades(req requests.SpreadPublicTrades, rCh ...bool) error {
+	m, err := okx.EncodeQuery(req)
+	if err != nil {
+		return err
+	}
+	if len(rCh) > 0 && rCh[0] {
+		c.sptCh = nil
+	}
+	return c.Unsubscribe(false, []okx.ChannelName{"sprd-public-trades"}, m)
+}
+
+// SpreadTickers
+// Retrieve the last traded price, best bid and ask of a spread.
+//
+// https://www.okx.com/docs-v5/en/#spread-trading-websocket-tickers-channel
+func (c *Public) SpreadTickers(req requests.SpreadTickers, ch ...chan *public.SpreadTickers) error {
+	m, err := okx.EncodeQuery(req)
+	if err != nil {
+		return err
+	}
+	if len(ch) > 0 {
+		c.stCh = ch[0]
+	}
+	return c.Subscribe(false, []okx.ChannelName{"sprd-tickers"}, m)
+}
+
+// USpreadTickers
+//
+// https://www.okx.com/docs-v5/en/#spread-trading-websocket-tickers-channel
+func (c *Public) USpreadTickers(req requests.SpreadTickers, rCh ...bool) error {
+	m, err := okx.EncodeQuery(req)
+	if err != nil {
+		return err
+	}
+	if len(rCh) > 0 && rCh[0] {
+		c.stCh = nil
+	}
+	return c.Unsubscribe(false, []okx.ChannelName{"sprd-tickers"}, m)
+}
+
+// OnInstruments registers fn for the instruments channel, subscribing to it if needed
+//
+// https://www.okx.com/docs-v5/en/#websocket-api-public-channels-instruments-channel
+func (c *Public) OnInstruments(req requests.Instruments, fn func(*public.Instruments)) (*Handler, error) {
+	m, err := okx.EncodeQuery(req)
+	if err != nil {
+		return nil, err
+	}
+	m["channel"] = "instruments"
+	return c.on(false, m, func(e interface{}) { fn(e.(*public.Instruments)) })
+}
+
+// OnTickers registers fn for the tickers of a single instrument, subscribing to it if needed.
+// The tickers reach fn in the order OKX pushed them, see Handler.
+//
+// https://www.okx.com/docs-v5/en/#websocket-api-public-channels-tickers-channel
+func (c *Public) OnTickers(req requests.Tickers, fn func(*public.Tickers)) (*Handler, error) {
+	m, err := okx.EncodeQuery(req)
+	if err != nil {
+		return nil, err
+	}
+	m["channel"] = "tickers"
+	return c.on(false, m, func(e interface{}) { fn(e.(*public.Tickers)) })
+}
+
+// OnOpenInterest registers fn for the open-interest channel, subscribing to it if needed
+//
+// https://www.okx.com/docs-v5/en/#websocket-api-public-channels-open-interest-channel
+func (c *Public) OnOpenInterest(req requests.OpenInterest, fn func(*public.OpenInterest)) (*Handler, error) {
+	m, err := okx.EncodeQuery(req)
+	if err != nil {
+		return nil, err
+	}
+	m["channel"] = "open-interest"
+	return c.on(false, m, func(e interface{}) { fn(e.(*public.OpenInterest)) })
+}
+
+// OnCandlesticks registers fn for a candlesticks channel, subscribing to it if needed
+//
+// https://www.okx.com/docs-v5/en/#websocket-api-public-channels-candlesticks-channel
+func (c *Public) OnCandlesticks(req requests.Candlesticks, fn func(*public.Candlesticks)) (*Handler, error) {
+	m, err := okx.EncodeQuery(req)
+	if err != nil {
+		return nil, err
+	}
+	return c.on(false, m, func(e interface{}) { fn(e.(*public.Candlesticks)) })
+}
+
+// OnTrades registers fn for the trades channel, subscribing to it if needed
+//
+// https://www.okx.com/docs-v5/en/#websocket-api-public-channels-trades-channel
+func (c *Public) OnTrades(req requests.Trades, fn func(*public.Trades)) (*Handler, error) {
+	m, err := okx.EncodeQuery(req)
+	if err != nil {
+		return nil, err
+	}
+	m["channel"] = "trades"
+	return c.on(false, m, func(e interface{}) { fn(e.(*public.Trades)) })
+}
+
+// OnEstimatedDeliveryExercisePrice registers fn for the estimated-price channel, subscribing to it if needed
+//
+// https://www.okx.com/docs-v5/en/#websocket-api-public-channels-estimated-delivery-exercise-price-channel
+func (c *Public) OnEstimatedDeliveryExercisePrice(req requests.EstimatedDeliveryExercisePrice, fn func(*public.EstimatedDeliveryExercisePrice)) (*Handler, error) {
+	m, err := okx.EncodeQuery(req)
+	if err != nil {
+		return nil, err
+	}
+	m["channel"] = "estimated-price"
+	return c.on(false, m, func(e interface{}) { fn(e.(*public.EstimatedDeliveryExercisePrice)) })
+}
+
+// OnMarkPrice registers fn for the mark-price channel, subscribing to it if needed
+//
+// https://www.okx.com/docs-v5/en/#websocket-api-public-channels-mark-price-channel
+func (c *Public) OnMarkPrice(req requests.MarkPrice, fn func(*public.MarkPrice)) (*Handler, error) {
+	m, err := okx.EncodeQuery(req)
+	if err != nil {
+		return nil, err
+	}
+	m["channel"] = "mark-price"
+	return c.on(false, m, func(e interface{}) { fn(e.(*public.MarkPrice)) })
+}
+
+// OnMarkPriceCandlesticks registers fn for a mark price candlesticks channel, subscribing to it if needed
+//
+// https://www.okx.com/docs-v5/en/#websocket-api-public-channels-mark-price-candlesticks-channel
+func (c *Public) OnMarkPriceCandlesticks(req requests.MarkPriceCandlesticks, fn func(*public.MarkPriceCandlesticks)) (*Handler, error) {
+	m, err := okx.EncodeQuery(req)
+	if err != nil {
+		return nil, err
+	}
+	m["channel"] = "mark-price-" + m["channel"]
+	return c.on(false, m, func(e interface{}) { fn(e.(*public.MarkPriceCandlesticks)) })
+}
+
+// OnPriceLimit registers fn for the price-limit channel, subscribing to it if needed
+//
+// https://www.okx.com/docs-v5/en/#websocket-api-public-channels-price-limit-channel
+func (c *Public) OnPriceLimit(req requests.PriceLimit, fn func(*public.PriceLimit)) (*Handler, error) {
+	m, err := okx.EncodeQuery(req)
+	if err != nil {
+		return nil, err
+	}
+	m["channel"] = "price-limit"
+	return c.on(false, m, func(e interface{}) { fn(e.(*public.PriceLimit)) })
+}
+
+// OnOrderBook registers fn for an order book channel, subscribing to it if needed
+//
+// https://www.okx.com/docs-v5/en/#websocket-api-public-channels-order-book-channel
+func (c *Public) OnOrderBook(req requests.OrderBook, fn func(*public.OrderBook)) (*Handler, error) {
+	m, err := okx.EncodeQuery(req)
+	if err != nil {
+		return nil, err
+	}
+	return c.on(false, m, func(e interface{}) { fn(e.(*public.OrderBook)) })
+}
+
+// OnOPTIONSummary registers fn for the opt-summary channel, subscribing to it if needed
+//
+// https://www.okx.com/docs-v5/en/#websocket-api-public-channels-option-summary-channel
+func (c *Public) OnOPTIONSummary(req requests.OPTIONSummary, fn func(*public.OPTIONSummary)) (*Handler, error) {
+	m, err := okx.EncodeQuery(req)
+	if err != nil {
+		return nil, err
+	}
+	m["channel"] = "opt-summary"
+	return c.on(false, m, func(e interface{}) { fn(e.(*public.OPTIONSummary)) })
+}
+
+// OnFundingRate registers fn for the funding-rate channel, subscribing to it if needed
+//
+// https://www.okx.com/docs-v5/en/#websocket-api-public-channels-funding-rate-channel
+func (c *Public) OnFundingRate(req requests.FundingRate, fn func(*public.FundingRate)) (*Handler, error) {
+	m, err := okx.EncodeQuery(req)
+	if err != nil {
+		return nil, err
+	}
+	m["channel"] = "funding-rate"
+	return c.on(false, m, func(e interface{}) { fn(e.(*public.FundingRate)) })
+}
+
+// OnIndexCandlesticks registers fn for an index candlesticks channel, subscribing to it if needed
+//
+// https://www.okx.com/docs-v5/en/#websocket-api-public-channels-index-candlesticks-channel
+func (c *Public) OnIndexCandlesticks(req requests.IndexCandlesticks, fn func(*public.IndexCandlesticks)) (*Handler, error) {
+	m, err := okx.EncodeQuery(req)
+	if err != nil {
+		return nil, err
+	}
+	m["channel"] = req.Channel
+	return c.on(false, m, func(e interface{}) { fn(e.(*public.IndexCandlesticks)) })
+}
+
+// OnIndexTickers registers fn for the index-tickers channel, subscribing to it if needed
+//
+// https://www.okx.com/docs-v5/en/#websocket-api-public-channels-index-tickers-channel
+func (c *Public) OnIndexTickers(req requests.IndexTickers, fn func(*public.IndexTickers)) (*Handler, error) {
+	m, err := okx.EncodeQuery(req)
+	if err != nil {
+		return nil, err
+	}
+	m["channel"] = "index-tickers"
+	return c.on(false, m, func(e interface{}) { fn(e.(*public.IndexTickers)) })
+}
+
+// OnSpreadOrderBook registers fn for the sprd-books5 channel, subscribing to it if needed
+//
+// https://www.okx.com/docs-v5/en/#spread-trading-websocket-order-book-channel
+func (c *Public) OnSpreadOrderBook(req requests.SpreadOrderBook, fn func(*public.SpreadOrderBook)) (*Handler, error) {
+	m, err := okx.EncodeQuery(req)
+	if err != nil {
+		return nil, err
+	}
+	m["channel"] = "sprd-books5"
+	return c.on(false, m, func(e interface{}) { fn(e.(*public.SpreadOrderBook)) })
+}
+
+// OnSpreadPublicTrades registers fn for the sprd-public-trades channel, subscribing to it if needed
+//
+// https://www.okx.com/docs-v5/en/#spread-trading-websocket-public-trades-channel
+func (c *Public) OnSpreadPublicTrades(req requests.SpreadPublicTrades, fn func(*public.SpreadPublicTrades)) (*Handler, error) {
+	m, err := okx.EncodeQuery(req)
+	if err != nil {
+		return nil, err
+	}
+	m["channel"] = "sprd-public-trades"
+	return c.on(false, m, func(e interface{}) { fn(e.(*public.SpreadPublicTrades)) })
+}
+
+// OnSpreadTickers registers fn for the sprd-tickers channel, subscribing to it if needed
+//
+// https://www.okx.com/docs-v5/en/#spread-trading-websocket-tickers-channel
+func (c *Public) OnSpreadTickers(req requests.SpreadTickers, fn func(*public.SpreadTickers)) (*Handler, error) {
+	m, err := okx.EncodeQuery(req)
+	if err != nil {
+		return nil, err
+	}
+	m["channel"] = "sprd-tickers"
+	return c.on(false, m, func(e interface{}) { fn(e.(*public.SpreadTickers)) })
+}
+
 func (c *Public) Process(data []byte, e *events.Basic) bool {
 	if e.Event == "" && e.Arg != nil && e.Data != nil && len(e.Data) > 0 {
 		ch, ok := e.Arg.Get("channel")
 		if !ok {
 			return false
 		}
+		chName := fmt.Sprint(ch)
 		switch ch {
 		case "instruments":
 			e := public.Instruments{}
 			err := json.Unmarshal(data, &e)
 			if err != nil {
-				return false
+				c.decodeError(chName, data, err)
+				return true
 			}
+			c.dispatch(chName, e.Arg, &e)
 			go func() {
 				if c.iCh != nil {
 					c.iCh <- &e
@@ -390,8 +772,10 @@ func (c *Public) Process(data []byte, e *events.Basic) bool {
 			e := public.Tickers{}
 			err := json.Unmarshal(data, &e)
 			if err != nil {
-				return false
+				c.decodeError(chName, data, err)
+				return true
 			}
+			c.dispatch(chName, e.Arg, &e)
 			go func() {
 				if c.tCh != nil {
 					c.tCh <- &e
@@ -402,8 +786,10 @@ func (c *Public) Process(data []byte, e *events.Basic) bool {
 			e := public.OpenInterest{}
 			err := json.Unmarshal(data, &e)
 			if err != nil {
-				return false
+				c.decodeError(chName, data, err)
+				return true
 			}
+			c.dispatch(chName, e.Arg, &e)
 			go func() {
 				if c.oiCh != nil {
 					c.oiCh <- &e
@@ -414,8 +800,10 @@ func (c *Public) Process(data []byte, e *events.Basic) bool {
 			e := public.Trades{}
 			err := json.Unmarshal(data, &e)
 			if err != nil {
-				return false
+				c.decodeError(chName, data, err)
+				return true
 			}
+			c.dispatch(chName, e.Arg, &e)
 			go func() {
 				if c.trCh != nil {
 					c.trCh <- &e
@@ -426,8 +814,10 @@ func (c *Public) Process(data []byte, e *events.Basic) bool {
 			e := public.EstimatedDeliveryExercisePrice{}
 			err := json.Unmarshal(data, &e)
 			if err != nil {
-				return false
+				c.decodeError(chName, data, err)
+				return true
 			}
+			c.dispatch(chName, e.Arg, &e)
 			go func() {
 				if c.edepCh != nil {
 					c.edepCh <- &e
@@ -438,8 +828,10 @@ func (c *Public) Process(data []byte, e *events.Basic) bool {
 			e := public.MarkPrice{}
 			err := json.Unmarshal(data, &e)
 			if err != nil {
-				return false
+				c.decodeError(chName, data, err)
+				return true
 			}
+			c.dispatch(chName, e.Arg, &e)
 			go func() {
 				if c.mpCh != nil {
 					c.mpCh <- &e
@@ -450,8 +842,10 @@ func (c *Public) Process(data []byte, e *events.Basic) bool {
 			e := public.PriceLimit{}
 			err := json.Unmarshal(data, &e)
 			if err != nil {
-				return false
+				c.decodeError(chName, data, err)
+				return true
 			}
+			c.dispatch(chName, e.Arg, &e)
 			go func() {
 				if c.plCh != nil {
 					c.plCh <- &e
@@ -462,8 +856,10 @@ func (c *Public) Process(data []byte, e *events.Basic) bool {
 			e := public.OPTIONSummary{}
 			err := json.Unmarshal(data, &e)
 			if err != nil {
-				return false
+				c.decodeError(chName, data, err)
+				return true
 			}
+			c.dispatch(chName, e.Arg, &e)
 			go func() {
 				if c.osCh != nil {
 					c.osCh <- &e
@@ -471,14 +867,16 @@ func (c *Public) Process(data []byte, e *events.Basic) bool {
 			}()
 			return true
 		case "funding-rate":
-			e := public.OPTIONSummary{}
+			e := public.FundingRate{}
 			err := json.Unmarshal(data, &e)
 			if err != nil {
-				return false
+				c.decodeError(chName, data, err)
+				return true
 			}
+			c.dispatch(chName, e.Arg, &e)
 			go func() {
-				if c.osCh != nil {
-					c.osCh <- &e
+				if c.frCh != nil {
+					c.frCh <- &e
 				}
 			}()
 			return true
@@ -486,25 +884,69 @@ func (c *Public) Process(data []byte, e *events.Basic) bool {
 			e := public.IndexTickers{}
 			err := json.Unmarshal(data, &e)
 			if err != nil {
-				return false
+				c.decodeError(chName, data, err)
+				return true
 			}
+			c.dispatch(chName, e.Arg, &e)
 			go func() {
 				if c.itCh != nil {
 					c.itCh <- &e
 				}
 			}()
 			return true
+		case "sprd-books5":
+			e := public.SpreadOrderBook{}
+			err := json.Unmarshal(data, &e)
+			if err != nil {
+				c.decodeError(chName, data, err)
+				return true
+			}
+			c.dispatch(chName, e.Arg, &e)
+			go func() {
+				if c.sobCh != nil {
+					c.sobCh <- &e
+				}
+			}()
+			return true
+		case "sprd-public-trades":
+			e := public.SpreadPublicTrades{}
+			err := json.Unmarshal(data, &e)
+			if err != nil {
+				c.decodeError(chName, data, err)
+				return true
+			}
+			c.dispatch(chName, e.Arg, &e)
+			go func() {
+				if c.sptCh != nil {
+					c.sptCh <- &e
+				}
+			}()
+			return true
+		case "sprd-tickers":
+			e := public.SpreadTickers{}
+			err := json.Unmarshal(data, &e)
+			if err != nil {
+				c.decodeError(chName, data, err)
+				return true
+			}
+			c.dispatch(chName, e.Arg, &e)
+			go func() {
+				if c.stCh != nil {
+					c.stCh <- &e
+				}
+			}()
+			return true
 		default:
 			// special cases
-			// market price candlestick channel
-			chName := fmt.Sprint(ch)
 			// market price channels
 			if strings.Contains(chName, "mark-price-candle") {
 				e := public.MarkPriceCandlesticks{}
 				err := json.Unmarshal(data, &e)
 				if err != nil {
-					return false
+					c.decodeError(chName, data, err)
+					return true
 				}
+				c.dispatch(chName, e.Arg, &e)
 				go func() {
 					if c.mpcCh != nil {
 						c.mpcCh <- &e
@@ -517,8 +959,10 @@ func (c *Public) Process(data []byte, e *events.Basic) bool {
 				e := public.IndexCandlesticks{}
 				err := json.Unmarshal(data, &e)
 				if err != nil {
-					return false
+					c.decodeError(chName, data, err)
+					return true
 				}
+				c.dispatch(chName, e.Arg, &e)
 				go func() {
 					if c.icCh != nil {
 						c.icCh <- &e
@@ -531,8 +975,10 @@ func (c *Public) Process(data []byte, e *events.Basic) bool {
 				e := public.Candlesticks{}
 				err := json.Unmarshal(data, &e)
 				if err != nil {
-					return false
+					c.decodeError(chName, data, err)
+					return true
 				}
+				c.dispatch(chName, e.Arg, &e)
 				go func() {
 					if c.cCh != nil {
 						c.cCh <- &e
@@ -545,9 +991,10 @@ func (c *Public) Process(data []byte, e *events.Basic) bool {
 				e := public.OrderBook{}
 				err := json.Unmarshal(data, &e)
 				if err != nil {
-					fmt.Println(err.Error())
-					return false
+					c.decodeError(chName, data, err)
+					return true
 				}
+				c.dispatch(chName, e.Arg, &e)
 				if c.obCh != nil {
 					c.obCh <- &e
 				}
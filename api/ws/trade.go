@@ -1,15 +1,27 @@
 package ws
 
 import (
+	"strconv"
+	"sync/atomic"
+	"time"
+
 	"github.com/liuhengloveyou/okx-go"
+	sprdRequests "github.com/liuhengloveyou/okx-go/requests/rest/spread"
 	requests "github.com/liuhengloveyou/okx-go/requests/rest/trade"
+	"github.com/liuhengloveyou/okx-go/risk"
 )
 
 // Trade
 //
+// Orders are held back client-side to stay within the rate limits of OKX, see SetRateLimit,
+// and checked by the risk gate of the client, see SetRiskGate.
+// OKX has no websocket operation to amend algo orders, use rest.Trade.AmendAlgoOrder instead.
+// The spread operations are served on the business websocket url, see okx.BusinessServer.
+//
 // https://www.okx.com/docs-v5/en/#websocket-api-trade
 type Trade struct {
 	*ClientWs
+	opts []okx.RequestOption
 }
 
 // NewTrade returns a pointer to a fresh Trade
@@ -17,24 +29,42 @@ func NewTrade(c *ClientWs) *Trade {
 	return &Trade{ClientWs: c}
 }
 
+// With returns a copy of Trade applying opts to the orders it places and amends, such as okx.WithExpiry.
+//
+// The ExpTime set on an order takes precedence over the options, headers don't apply to websocket operations.
+func (c *Trade) With(opts ...okx.RequestOption) *Trade {
+	return &Trade{ClientWs: c.ClientWs, opts: append(append([]okx.RequestOption{}, c.opts...), opts...)}
+}
+
 // PlaceOrder
 // You can place an order only if you have sufficient funds.
 //
 // https://www.okx.com/docs-v5/en/#websocket-api-trade-place-order
 //
-// Place orders in a batch. Maximum 20 orders can be placed at a time
+// Place orders in a batch. Batches larger than MaxBatchSize are split and sent one after the other,
+// each one with the ID of the batch followed by the index of the split batch, and the earliest ExpTime of its orders.
+// The ID of the batch is the first ID set on its orders, it's generated when none is.
 //
 // https://www.okx.com/docs-v5/en/#websocket-api-trade-place-multiple-orders
 func (c *Trade) PlaceOrder(req ...requests.PlaceOrder) error {
-	tmpArgs := make([]map[string]string, len(req))
-	op := okx.OrderOperation
-	if len(req) > 1 {
-		op = okx.BatchOrderOperation
-	}
+	args := make([]orderArg, len(req))
+	batch := make([]risk.Order, len(req))
 	for i, order := range req {
-		tmpArgs[i] = okx.S2M(order)
+		if order.Tag == "" {
+			order.Tag = c.brokerTag
+		}
+		if order.ClOrdID == "" && c.clOrdID != nil {
+			order.ClOrdID = c.clOrdID()
+		}
+		batch[i] = risk.NewOrder(order)
+		args[i] = orderArg{instID: order.InstID, id: order.ID, expTime: order.ExpTime, req: order, order: &batch[i]}
+	}
+	if c.risk != nil {
+		if err := c.risk.CheckBatch(batch); err != nil {
+			return err
+		}
 	}
-	return c.Send(true, op, tmpArgs, map[string]string{"id": req[0].ID})
+	return c.sendOrders(okx.OrderOperation, okx.BatchOrderOperation, true, args)
 }
 
 // CancelOrder
@@ -42,19 +72,17 @@ func (c *Trade) PlaceOrder(req ...requests.PlaceOrder) error {
 //
 // https://www.okx.com/docs-v5/en/#websocket-api-trade-place-order
 //
-// Cancel incomplete orders in batches. Maximum 20 orders can be canceled at a time.
+// Cancel incomplete orders in batches. Batches larger than MaxBatchSize are split and sent one after the other,
+// each one with the ID of the batch followed by the index of the split batch.
+// The ID of the batch is the first ID set on its orders, it's generated when none is.
 //
 // https://www.okx.com/docs-v5/en/#websocket-api-trade-cancel-multiple-orders
 func (c *Trade) CancelOrder(req ...requests.CancelOrder) error {
-	tmpArgs := make([]map[string]string, len(req))
-	op := okx.CancelOrderOperation
-	if len(req) > 1 {
-		op = okx.BatchCancelOrderOperation
-	}
+	args := make([]orderArg, len(req))
 	for i, order := range req {
-		tmpArgs[i] = okx.S2M(order)
+		args[i] = orderArg{instID: order.InstID, id: order.ID, req: order}
 	}
-	return c.Send(true, op, tmpArgs, map[string]string{"id": req[0].ID})
+	return c.sendOrders(okx.CancelOrderOperation, okx.BatchCancelOrderOperation, false, args)
 }
 
 // AmendOrder
@@ -62,17 +90,231 @@ func (c *Trade) CancelOrder(req ...requests.CancelOrder) error {
 //
 // https://www.okx.com/docs-v5/en/#websocket-api-trade-place-order
 //
-// Amend incomplete orders in batches. Maximum 20 orders can be amended at a time.
+// Amend incomplete orders in batches. Batches larger than MaxBatchSize are split and sent one after the other,
+// each one with the ID of the batch followed by the index of the split batch, and the earliest ExpTime of its orders.
+// The ID of the batch is the first ID set on its orders, it's generated when none is.
 //
 // https://www.okx.com/docs-v5/en/#websocket-api-trade-amend-multiple-orders
 func (c *Trade) AmendOrder(req ...requests.AmendOrder) error {
-	tmpArgs := make([]map[string]string, len(req))
-	op := okx.AmendOrderOperation
-	if len(req) > 1 {
-		op = okx.BatchAmendOrderOperation
-	}
+	args := make([]orderArg, len(req))
 	for i, order := range req {
-		tmpArgs[i] = okx.S2M(order)
+		if c.risk != nil {
+			if err := c.risk.CheckAmend(order.InstID, order.NewPx, order.NewSz); err != nil {
+				return err
+			}
+		}
+		args[i] = orderArg{instID: order.InstID, id: order.ID, expTime: order.ExpTime, req: order}
+	}
+	return c.sendOrders(okx.AmendOrderOperation, okx.BatchAmendOrderOperation, true, args)
+}
+
+// MassCancel
+// Cancel all the MMP pending orders of an instrument family, only applicable to OPTION in portfolio margin mode.
+//
+// https://www.okx.com/docs-v5/en/#order-book-trading-trade-ws-mass-cancel-order
+func (c *Trade) MassCancel(req requests.MassCancel) error {
+	if err := c.limiter.wait(c.ctx, okx.MassCancelOperation, ""); err != nil {
+		return err
+	}
+	arg, err := okx.EncodeBody(req)
+	if err != nil {
+		return err
+	}
+	return c.Send(true, okx.MassCancelOperation, []interface{}{arg}, map[string]string{"id": req.ID})
+}
+
+// PlaceSpreadOrder
+// Place a new order on a spread.
+//
+// https://www.okx.com/docs-v5/en/#spread-trading-websocket-trade-api-ws-place-order
+func (c *Trade) PlaceSpreadOrder(req sprdRequests.PlaceOrder) error {
+	if req.Tag == "" {
+		req.Tag = c.brokerTag
+	}
+	if req.ClOrdID == "" && c.clOrdID != nil {
+		req.ClOrdID = c.clOrdID()
+	}
+	arg, err := okx.EncodeBody(req)
+	if err != nil {
+		return err
+	}
+	o := risk.Order{InstID: req.SprdID, ClOrdID: req.ClOrdID, Side: req.Side, Sz: req.Sz, Px: req.Px}
+	if c.risk != nil {
+		if err := c.risk.Check(o); err != nil {
+			return err
+		}
+	}
+	err = c.limiter.wait(c.ctx, okx.SprdOrderOperation, "")
+	if err == nil {
+		err = c.Send(true, okx.SprdOrderOperation, []interface{}{arg}, map[string]string{"id": req.ID})
+	}
+	if err != nil && c.risk != nil {
+		c.risk.Release(o)
+	}
+	return err
+}
+
+// CancelSpreadOrder
+// Cancel an incomplete spread order.
+//
+// https://www.okx.com/docs-v5/en/#spread-trading-websocket-trade-api-ws-cancel-order
+func (c *Trade) CancelSpreadOrder(req sprdRequests.CancelOrder) error {
+	if err := c.limiter.wait(c.ctx, okx.SprdCancelOrderOperation, ""); err != nil {
+		return err
+	}
+	arg, err := okx.EncodeBody(req)
+	if err != nil {
+		return err
+	}
+	return c.Send(true, okx.SprdCancelOrderOperation, []interface{}{arg}, map[string]string{"id": req.ID})
+}
+
+// AmendSpreadOrder
+// Amend an incomplete spread order.
+//
+// Only the kill switch of the risk gate applies, the request doesn't name the spread of the order.
+//
+// https://www.okx.com/docs-v5/en/#spread-trading-websocket-trade-api-ws-amend-order
+func (c *Trade) AmendSpreadOrder(req sprdRequests.AmendOrder) error {
+	if c.risk != nil {
+		if err := c.risk.CheckKill(""); err != nil {
+			return err
+		}
+	}
+	if err := c.limiter.wait(c.ctx, okx.SprdAmendOrderOperation, ""); err != nil {
+		return err
+	}
+	arg, err := okx.EncodeBody(req)
+	if err != nil {
+		return err
 	}
-	return c.Send(true, op, tmpArgs, map[string]string{"id": req[0].ID})
+	return c.Send(true, okx.SprdAmendOrderOperation, []interface{}{arg}, map[string]string{"id": req.ID})
+}
+
+// MassCancelSpreadOrders
+// Cancel all the pending spread orders, or the ones of a spread when SprdID is set.
+//
+// https://www.okx.com/docs-v5/en/#spread-trading-websocket-trade-api-ws-cancel-all-orders
+func (c *Trade) MassCancelSpreadOrders(req sprdRequests.MassCancel) error {
+	if err := c.limiter.wait(c.ctx, okx.SprdMassCancelOperation, ""); err != nil {
+		return err
+	}
+	arg, err := okx.EncodeBody(req)
+	if err != nil {
+		return err
+	}
+	return c.Send(true, okx.SprdMassCancelOperation, []interface{}{arg}, map[string]string{"id": req.ID})
+}
+
+// orderArg is an order of a trade operation, with what's needed to limit and split its batch
+type orderArg struct {
+	instID  string
+	id      string
+	expTime time.Time
+	req     interface{}
+	order   *risk.Order // accepted by the risk gate, released when it isn't sent
+}
+
+// sendOrders sends args with the single operation, or with the batch one in batches of at most MaxBatchSize,
+// waiting for the client-side rate limit of every order. The options of Trade set the expTime when expires is set.
+//
+// Every batch is encoded before its orders are counted by the rate limit, so one that fails to encode doesn't use it up.
+// The orders of the batches not sent are released from the risk gate.
+func (c *Trade) sendOrders(single, batch okx.Operation, expires bool, args []orderArg) error {
+	var id string
+	for _, a := range args {
+		if a.id != "" {
+			id = a.id
+			break
+		}
+	}
+	if id == "" {
+		id = c.newOpID()
+	}
+
+	for start := 0; start < len(args); start += MaxBatchSize {
+		end := start + MaxBatchSize
+		if end > len(args) {
+			end = len(args)
+		}
+		op := single
+		if end-start > 1 {
+			op = batch
+		}
+		chunkID := id
+		if len(args) > MaxBatchSize {
+			chunkID = chunkOpID(id, start/MaxBatchSize)
+		}
+		if err := c.sendBatch(op, chunkID, expires, args[start:end]); err != nil {
+			c.release(args[start:])
+			return err
+		}
+	}
+
+	return nil
+}
+
+// sendBatch sends the orders of a single batch with op
+func (c *Trade) sendBatch(op okx.Operation, id string, expires bool, args []orderArg) error {
+	tmpArgs := make([]interface{}, len(args))
+	var expTime time.Time
+	for i, a := range args {
+		arg, err := okx.EncodeBody(a.req)
+		if err != nil {
+			return err
+		}
+		tmpArgs[i] = arg
+		if !a.expTime.IsZero() && (expTime.IsZero() || a.expTime.Before(expTime)) {
+			expTime = a.expTime
+		}
+	}
+	for _, a := range args {
+		if err := c.limiter.wait(c.ctx, op, a.instID); err != nil {
+			return err
+		}
+	}
+	if expTime.IsZero() && expires {
+		expTime = okx.NewRequestOptions(c.opts...).ExpiresAt(c.clock)
+	}
+
+	extra := map[string]string{"id": id}
+	if !expTime.IsZero() {
+		extra["expTime"] = okx.FormatExpTime(expTime)
+	}
+
+	return c.Send(true, op, tmpArgs, extra)
+}
+
+// release releases from the risk gate the orders of args it accepted
+func (c *Trade) release(args []orderArg) {
+	if c.risk == nil {
+		return
+	}
+	var orders []risk.Order
+	for _, a := range args {
+		if a.order != nil {
+			orders = append(orders, *a.order)
+		}
+	}
+	c.risk.Release(orders...)
+}
+
+// maxOpIDLen is the maximum length of the id of an operation accepted by OKX
+const maxOpIDLen = 32
+
+// newOpID returns an alphanumeric operation id unique to the client
+func (c *ClientWs) newOpID() string {
+	seq := atomic.AddUint64(&c.opSeq, 1)
+
+	return strconv.FormatInt(time.Now().UnixMilli(), 36) + strconv.FormatUint(seq, 36)
+}
+
+// chunkOpID returns the id of the split batch i of the batch id, trimmed to maxOpIDLen
+func chunkOpID(id string, i int) string {
+	suffix := strconv.Itoa(i)
+	if len(id)+len(suffix) > maxOpIDLen {
+		id = id[:maxOpIDLen-len(suffix)]
+	}
+
+	return id + suffix
 }
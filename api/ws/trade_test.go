@@ -0,0 +1,204 @@
+package ws
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/liuhengloveyou/okx-go"
+	requests "github.com/liuhengloveyou/okx-go/requests/rest/trade"
+	"github.com/liuhengloveyou/okx-go/risk"
+)
+
+type testFrame struct {
+	ID      string            `json:"id"`
+	Op      okx.Operation     `json:"op"`
+	ExpTime string            `json:"expTime"`
+	Args    []json.RawMessage `json:"args"`
+}
+
+// newRecordingClient returns an authorized client sending its frames to the returned channel
+func newRecordingClient(t *testing.T) (*ClientWs, <-chan testFrame) {
+	t.Helper()
+	frames := make(chan testFrame, 100)
+	srv := newTestServer(t, func(conn *websocket.Conn) {
+		for {
+			_, data, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			var f testFrame
+			if json.Unmarshal(data, &f) == nil {
+				frames <- f
+			}
+		}
+	})
+	c := newTestClient(t, srv)
+	c.Authorized = true
+
+	return c, frames
+}
+
+func receiveFrames(t *testing.T, frames <-chan testFrame, n int) []testFrame {
+	t.Helper()
+	res := make([]testFrame, 0, n)
+	for len(res) < n {
+		select {
+		case f := <-frames:
+			res = append(res, f)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("received %d frames, want %d", len(res), n)
+		}
+	}
+
+	return res
+}
+
+func cancels(n int, ids map[int]string) []requests.CancelOrder {
+	res := make([]requests.CancelOrder, n)
+	for i := range res {
+		res[i] = requests.CancelOrder{ID: ids[i], InstID: "BTC-USDT", OrdID: strconv.Itoa(i)}
+	}
+
+	return res
+}
+
+func TestTradeSendOrdersSplit(t *testing.T) {
+	tests := []struct {
+		name     string
+		orders   []requests.CancelOrder
+		wantOps  []okx.Operation
+		wantArgs []int
+		wantIDs  []string // empty for generated ids
+	}{
+		{
+			name:     "single order",
+			orders:   cancels(1, map[int]string{0: "c1"}),
+			wantOps:  []okx.Operation{okx.CancelOrderOperation},
+			wantArgs: []int{1},
+			wantIDs:  []string{"c1"},
+		},
+		{
+			name:     "single batch",
+			orders:   cancels(MaxBatchSize, map[int]string{0: "c1"}),
+			wantOps:  []okx.Operation{okx.BatchCancelOrderOperation},
+			wantArgs: []int{MaxBatchSize},
+			wantIDs:  []string{"c1"},
+		},
+		{
+			name:     "split batch with the id of a later order",
+			orders:   cancels(2*MaxBatchSize+1, map[int]string{3: "c1"}),
+			wantOps:  []okx.Operation{okx.BatchCancelOrderOperation, okx.BatchCancelOrderOperation, okx.CancelOrderOperation},
+			wantArgs: []int{MaxBatchSize, MaxBatchSize, 1},
+			wantIDs:  []string{"c10", "c11", "c12"},
+		},
+		{
+			name:     "split batch without id",
+			orders:   cancels(MaxBatchSize+5, nil),
+			wantOps:  []okx.Operation{okx.BatchCancelOrderOperation, okx.BatchCancelOrderOperation},
+			wantArgs: []int{MaxBatchSize, 5},
+			wantIDs:  []string{"", ""},
+		},
+		{
+			name:     "long id trimmed",
+			orders:   cancels(MaxBatchSize+1, map[int]string{0: "c1234567890123456789012345678901"}),
+			wantOps:  []okx.Operation{okx.BatchCancelOrderOperation, okx.CancelOrderOperation},
+			wantArgs: []int{MaxBatchSize, 1},
+			wantIDs:  []string{"c1234567890123456789012345678900", "c1234567890123456789012345678901"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, frames := newRecordingClient(t)
+			if err := c.Trade.CancelOrder(tt.orders...); err != nil {
+				t.Fatalf("CancelOrder() = %v", err)
+			}
+			got := receiveFrames(t, frames, len(tt.wantOps))
+			seen := make(map[string]bool)
+			for i, f := range got {
+				if f.Op != tt.wantOps[i] || len(f.Args) != tt.wantArgs[i] {
+					t.Errorf("frame %d is %s with %d args, want %s with %d", i, f.Op, len(f.Args), tt.wantOps[i], tt.wantArgs[i])
+				}
+				if f.ID == "" || len(f.ID) > maxOpIDLen || seen[f.ID] {
+					t.Errorf("frame %d id %q is empty, too long or not unique", i, f.ID)
+				}
+				seen[f.ID] = true
+				if i < len(tt.wantIDs) && tt.wantIDs[i] != "" && f.ID != tt.wantIDs[i] {
+					t.Errorf("frame %d id = %q, want %q", i, f.ID, tt.wantIDs[i])
+				}
+			}
+		})
+	}
+}
+
+func TestTradeSendOrdersExpTime(t *testing.T) {
+	base := time.Now().Add(time.Minute).Truncate(time.Millisecond)
+	amends := make([]requests.AmendOrder, MaxBatchSize+2)
+	for i := range amends {
+		amends[i] = requests.AmendOrder{InstID: "BTC-USDT", OrdID: strconv.Itoa(i), NewSz: 1}
+	}
+	amends[5].ExpTime = base.Add(2 * time.Second)
+	amends[7].ExpTime = base.Add(time.Second)
+
+	c, frames := newRecordingClient(t)
+	before := time.Now()
+	if err := c.Trade.With(okx.WithExpiry(10 * time.Second)).AmendOrder(amends...); err != nil {
+		t.Fatalf("AmendOrder() = %v", err)
+	}
+	got := receiveFrames(t, frames, 2)
+
+	// the earliest ExpTime of the first batch, the expiry option for the second one
+	if want := okx.FormatExpTime(base.Add(time.Second)); got[0].ExpTime != want {
+		t.Errorf("first batch expTime = %s, want %s", got[0].ExpTime, want)
+	}
+	exp, err := strconv.ParseInt(got[1].ExpTime, 10, 64)
+	if err != nil {
+		t.Fatalf("second batch expTime %q: %v", got[1].ExpTime, err)
+	}
+	if at := time.UnixMilli(exp); at.Before(before.Add(10*time.Second).Truncate(time.Millisecond)) || at.After(time.Now().Add(10*time.Second)) {
+		t.Errorf("second batch expTime = %v, want 10s from now", at)
+	}
+
+	// cancels don't expire
+	if err := c.Trade.With(okx.WithExpiry(10 * time.Second)).CancelOrder(cancels(1, nil)...); err != nil {
+		t.Fatalf("CancelOrder() = %v", err)
+	}
+	if f := receiveFrames(t, frames, 1)[0]; f.ExpTime != "" {
+		t.Errorf("cancel expTime = %q, want none", f.ExpTime)
+	}
+}
+
+func TestTradeSendOrdersEncodeError(t *testing.T) {
+	c := NewClient(context.Background(), "", "", "", nil)
+	c.SetRateLimit(okx.BatchOrderOperation, RateLimit{N: 1, Window: time.Hour})
+	args := []orderArg{
+		{instID: "BTC-USDT", req: requests.CancelOrder{InstID: "BTC-USDT"}},
+		{instID: "BTC-USDT", req: make(chan int)},
+	}
+	if err := c.Trade.sendOrders(okx.OrderOperation, okx.BatchOrderOperation, false, args); err == nil {
+		t.Fatal("sendOrders() of an unencodable order succeeded")
+	}
+	if d := c.limiter.reserve(okx.BatchOrderOperation, "BTC-USDT", time.Now()); d != 0 {
+		t.Errorf("rate limit used up by a batch that wasn't sent, wait %v", d)
+	}
+}
+
+func TestTradePlaceOrderReleasesRisk(t *testing.T) {
+	c := NewClient(context.Background(), "", "", "", nil)
+	g := risk.NewGate(risk.Limits{MaxOpenOrders: 1})
+	c.SetRiskGate(g)
+	if err := c.Close(context.Background()); err != nil {
+		t.Fatalf("Close() = %v", err)
+	}
+
+	order := requests.PlaceOrder{InstID: "BTC-USDT", Side: okx.OrderBuy, OrdType: okx.OrderLimit, Sz: 1, Px: 100}
+	for i := 0; i < 2; i++ {
+		// the gate would reject the second order if the first one was still counted as open
+		if err := c.Trade.PlaceOrder(order); err != ErrClosing {
+			t.Fatalf("PlaceOrder() = %v, want ErrClosing", err)
+		}
+	}
+}
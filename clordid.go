@@ -0,0 +1,100 @@
+package okx
+
+import (
+	"crypto/rand"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// MaxClOrdIDLen is the maximum length of the client order IDs accepted by OKX
+	MaxClOrdIDLen = 32
+
+	clOrdIDTimeLen = 9 // milliseconds since the epoch in base 36, good until the year 5188
+	clOrdIDSeqLen  = 4 // sequence within the millisecond in base 36
+	clOrdIDNodeLen = 6 // random per generator in base 36
+	clOrdIDLen     = clOrdIDTimeLen + clOrdIDSeqLen + clOrdIDNodeLen
+
+	// MaxClOrdIDPrefixLen is the maximum length of the prefix of the IDs of NewClOrdIDGenerator
+	MaxClOrdIDPrefixLen = MaxClOrdIDLen - clOrdIDLen
+)
+
+// ClOrdIDGenerator returns a new client order ID every time it's called, it must be safe for concurrent use
+type ClOrdIDGenerator func() string
+
+// NewClOrdIDGenerator returns a generator of alphanumeric client order IDs made of prefix, the time in milliseconds,
+// a sequence and a random part drawn once per generator.
+//
+// The IDs of a generator are monotonic, they sort in the order they were generated even if the clock goes back,
+// and the random part keeps the generators of different processes with the same prefix from colliding.
+// The prefix, such as the name of a strategy, must be alphanumeric and at most MaxClOrdIDPrefixLen long.
+func NewClOrdIDGenerator(prefix string) (ClOrdIDGenerator, error) {
+	if len(prefix) > MaxClOrdIDPrefixLen {
+		return nil, fmt.Errorf("okx: client order id prefix %q longer than %d", prefix, MaxClOrdIDPrefixLen)
+	}
+	for _, r := range prefix {
+		if !(r >= '0' && r <= '9' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z') {
+			return nil, fmt.Errorf("okx: client order id prefix %q isn't alphanumeric", prefix)
+		}
+	}
+	node, err := randomBase36(clOrdIDNodeLen)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		mu     sync.Mutex
+		last   int64
+		seq    int64
+		maxSeq = pow36(clOrdIDSeqLen)
+	)
+	return func() string {
+		mu.Lock()
+		now := time.Now().UnixMilli()
+		if now > last {
+			last, seq = now, 0
+		} else {
+			seq++
+			if seq == maxSeq {
+				last, seq = last+1, 0
+			}
+		}
+		ms, n := last, seq
+		mu.Unlock()
+
+		return prefix + padBase36(ms, clOrdIDTimeLen) + padBase36(n, clOrdIDSeqLen) + node
+	}, nil
+}
+
+func padBase36(n int64, width int) string {
+	s := strconv.FormatInt(n, 36)
+	if len(s) >= width {
+		return s[len(s)-width:]
+	}
+
+	return strings.Repeat("0", width-len(s)) + s
+}
+
+func pow36(n int) int64 {
+	p := int64(1)
+	for i := 0; i < n; i++ {
+		p *= 36
+	}
+
+	return p
+}
+
+func randomBase36(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	for i := range b {
+		b[i] = "0123456789abcdefghijklmnopqrstuvwxyz"[int(b[i])%36]
+	}
+
+	return string(b), nil
+}
@@ -0,0 +1,42 @@
+package okx
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNewClOrdIDGenerator(t *testing.T) {
+	tests := []struct {
+		name    string
+		prefix  string
+		wantErr bool
+	}{
+		{name: "no prefix", prefix: ""},
+		{name: "prefix", prefix: "grid1"},
+		{name: "longest prefix", prefix: strings.Repeat("a", MaxClOrdIDPrefixLen)},
+		{name: "prefix too long", prefix: strings.Repeat("a", MaxClOrdIDPrefixLen+1), wantErr: true},
+		{name: "prefix not alphanumeric", prefix: "grid-1", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gen, err := NewClOrdIDGenerator(tt.prefix)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewClOrdIDGenerator() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			prev := ""
+			for i := 0; i < 10000; i++ {
+				id := gen()
+				if !strings.HasPrefix(id, tt.prefix) || len(id) != len(tt.prefix)+clOrdIDLen || len(id) > MaxClOrdIDLen {
+					t.Fatalf("id %q doesn't match the prefix %q and length %d", id, tt.prefix, len(tt.prefix)+clOrdIDLen)
+				}
+				if id <= prev {
+					t.Fatalf("id %q not after %q", id, prev)
+				}
+				prev = id
+			}
+		})
+	}
+}
@@ -4,9 +4,9 @@
 package okx
 
 import (
-	"encoding/json"
 	"strconv"
 	"strings"
+	"sync/atomic"
 	"time"
 )
 
@@ -39,6 +39,16 @@ type (
 	InstrumentState      string
 	DeliveryExerciseType string
 	CandleStickWsBarSize string
+	SpreadType           string
+	SpreadState          string
+	RFQState             string
+	GridDirection        string
+	GridRunType          string
+	RecurringPeriod      string
+	IsolatedMode         string
+	AccountLevel         string
+	QuickMarginSide      string
+	TriggerPxType        string
 
 	Destination           int
 	BillType              uint16
@@ -98,6 +108,8 @@ const (
 	SwapInstrument    = InstrumentType("SWAP")
 	FuturesInstrument = InstrumentType("FUTURES")
 	OptionsInstrument = InstrumentType("OPTION")
+	// AnyInstrument subscribes the private channels to every instrument type
+	AnyInstrument = InstrumentType("ANY")
 
 	MarginCrossMode    = MarginMode("cross")
 	MarginIsolatedMode = MarginMode("isolated")
@@ -223,6 +235,11 @@ const (
 	BatchCancelOrderOperation = Operation("batch-cancel-orders")
 	AmendOrderOperation       = Operation("amend-order")
 	BatchAmendOrderOperation  = Operation("batch-amend-orders")
+	MassCancelOperation       = Operation("mass-cancel")
+	SprdOrderOperation        = Operation("sprd-order")
+	SprdCancelOrderOperation  = Operation("sprd-cancel-order")
+	SprdAmendOrderOperation   = Operation("sprd-amend-order")
+	SprdMassCancelOperation   = Operation("sprd-mass-cancel")
 
 	OrderMarket   = OrderType("market")
 	OrderLimit    = OrderType("limit")
@@ -249,6 +266,12 @@ const (
 	AlgoOrderIceberg  = AlgoOrderType("iceberg")
 	AlgoOrderTwap     = AlgoOrderType("twap")
 	AlgoOrderTrailing = AlgoOrderType("move_order_stop")
+	// AlgoOrderGrid is a spot grid trading bot
+	AlgoOrderGrid = AlgoOrderType("grid")
+	// AlgoOrderContractGrid is a contract grid trading bot
+	AlgoOrderContractGrid = AlgoOrderType("contract_grid")
+	// AlgoOrderRecurring is a recurring buy trading bot
+	AlgoOrderRecurring = AlgoOrderType("recurring")
 
 	QuantityBaseCcy  = QuantityType("base_ccy")
 	QuantityQuoteCcy = QuantityType("quote_ccy")
@@ -323,6 +346,49 @@ const (
 	Exercise   = DeliveryExerciseType("exercised")
 	ExpiredOtm = DeliveryExerciseType("expired_otm")
 
+	SpreadLinear  = SpreadType("linear")
+	SpreadInverse = SpreadType("inverse")
+	SpreadHybrid  = SpreadType("hybrid")
+
+	SpreadLive    = SpreadState("live")
+	SpreadSuspend = SpreadState("suspend")
+	SpreadExpired = SpreadState("expired")
+
+	RFQActive      = RFQState("active")
+	RFQCanceled    = RFQState("canceled")
+	RFQPendingFill = RFQState("pending_fill")
+	RFQFilled      = RFQState("filled")
+	RFQExpired     = RFQState("expired")
+	RFQTradedAway  = RFQState("traded_away")
+	RFQFailed      = RFQState("failed")
+
+	GridLong    = GridDirection("long")
+	GridShort   = GridDirection("short")
+	GridNeutral = GridDirection("neutral")
+
+	GridArithmetic = GridRunType("1")
+	GridGeometric  = GridRunType("2")
+
+	RecurringMonthly = RecurringPeriod("monthly")
+	RecurringWeekly  = RecurringPeriod("weekly")
+	RecurringDaily   = RecurringPeriod("daily")
+	RecurringHourly  = RecurringPeriod("hourly")
+
+	IsolatedAutomatic = IsolatedMode("automatic")
+	IsolatedAutonomy  = IsolatedMode("autonomy")
+
+	AccountSimple          = AccountLevel("1")
+	AccountSingleCcyMargin = AccountLevel("2")
+	AccountMultiCcyMargin  = AccountLevel("3")
+	AccountPortfolioMargin = AccountLevel("4")
+
+	QuickMarginBorrow = QuickMarginSide("borrow")
+	QuickMarginRepay  = QuickMarginSide("repay")
+
+	TriggerPxLast  = TriggerPxType("last")
+	TriggerPxIndex = TriggerPxType("index")
+	TriggerPxMark  = TriggerPxType("mark")
+
 	CandleStick1Y  = CandleStickWsBarSize("candle1Y")
 	CandleStick6M  = CandleStickWsBarSize("candle6M")
 	CandleStick3M  = CandleStickWsBarSize("candle3M")
@@ -346,121 +412,148 @@ const (
 	ConvertTypeCurrency = ConvertType(2)
 )
 
+// lenientDecoding is shared by every client of the process, see SetLenientDecoding
+var lenientDecoding int32
+
+// SetLenientDecoding makes the JSON* types and the numeric enums decode the placeholders OKX is known to send
+// in place of numbers (such as "-" or "NaN") as zero values instead of failing the whole message.
+//
+// Empty strings and null are always decoded as zero values.
+//
+// The setting is global to the process: the types decode themselves through json.Unmarshal, with no way to know
+// which client the message came from, so it applies to every REST and websocket client at once, and to any other
+// decoding of these types. Set it once at startup rather than toggling it while clients are running.
+func SetLenientDecoding(on bool) {
+	var v int32
+	if on {
+		v = 1
+	}
+	atomic.StoreInt32(&lenientDecoding, v)
+}
+
+func lenient(err error) error {
+	if atomic.LoadInt32(&lenientDecoding) == 1 {
+		return nil
+	}
+
+	return err
+}
+
 func (t JSONTime) String() string { return time.Time(t).String() }
 
 func (t *JSONTime) UnmarshalJSON(s []byte) (err error) {
 	r := strings.Replace(string(s), `"`, ``, -1)
-	if r == "" {
+	if r == "" || r == "null" {
 		return
 	}
 
 	q, err := strconv.ParseInt(r, 10, 64)
 	if err != nil {
-		return err
+		return lenient(err)
 	}
 	*(*time.Time)(t) = time.UnixMilli(q)
 	return
 }
 func (t *JSONFloat64) UnmarshalJSON(s []byte) (err error) {
 	r := strings.Replace(string(s), `"`, ``, -1)
-	if r == "" {
+	if r == "" || r == "null" {
 		return
 	}
 
 	q, err := strconv.ParseFloat(r, 64)
 	if err != nil {
-		return err
+		return lenient(err)
 	}
 	*(*float64)(t) = q
 	return
 }
 func (t *JSONInt64) UnmarshalJSON(s []byte) (err error) {
 	r := strings.Replace(string(s), `"`, ``, -1)
-	if r == "" {
+	if r == "" || r == "null" {
 		return
 	}
 
 	q, err := strconv.ParseInt(r, 10, 64)
 	if err != nil {
-		return err
+		return lenient(err)
 	}
 	*(*int64)(t) = q
 	return
 }
 func (t *WithdrawalState) UnmarshalJSON(s []byte) (err error) {
 	r := strings.Replace(string(s), `"`, ``, -1)
-	if r == "" {
+	if r == "" || r == "null" {
 		return
 	}
 
 	q, err := strconv.ParseInt(r, 10, 8)
 	if err != nil {
-		return err
+		return lenient(err)
 	}
 	*(*int8)(t) = int8(q)
 	return
 }
 func (t *BillType) UnmarshalJSON(s []byte) (err error) {
 	r := strings.Replace(string(s), `"`, ``, -1)
-	if r == "" {
+	if r == "" || r == "null" {
 		return
 	}
 
 	q, err := strconv.ParseUint(r, 10, 16)
 	if err != nil {
-		return err
+		return lenient(err)
 	}
 	*(*uint16)(t) = uint16(q)
 	return
 }
 func (t *BillSubType) UnmarshalJSON(s []byte) (err error) {
 	r := strings.Replace(string(s), `"`, ``, -1)
-	if r == "" {
+	if r == "" || r == "null" {
 		return
 	}
 
 	q, err := strconv.ParseUint(r, 10, 16)
 	if err != nil {
-		return err
+		return lenient(err)
 	}
 	*(*uint16)(t) = uint16(q)
 	return
 }
 func (t *FeeCategory) UnmarshalJSON(s []byte) (err error) {
 	r := strings.Replace(string(s), `"`, ``, -1)
-	if r == "" {
+	if r == "" || r == "null" {
 		return
 	}
 
 	q, err := strconv.ParseUint(r, 10, 16)
 	if err != nil {
-		return err
+		return lenient(err)
 	}
 	*(*uint16)(t) = uint16(q)
 	return
 }
 func (t *AccountType) UnmarshalJSON(s []byte) (err error) {
 	r := strings.Replace(string(s), `"`, ``, -1)
-	if r == "" {
+	if r == "" || r == "null" {
 		return
 	}
 
 	q, err := strconv.ParseUint(r, 10, 16)
 	if err != nil {
-		return err
+		return lenient(err)
 	}
 	*(*uint16)(t) = uint16(q)
 	return
 }
 func (t *DepositState) UnmarshalJSON(s []byte) (err error) {
 	r := strings.Replace(string(s), `"`, ``, -1)
-	if r == "" {
+	if r == "" || r == "null" {
 		return
 	}
 
 	q, err := strconv.ParseUint(r, 10, 16)
 	if err != nil {
-		return err
+		return lenient(err)
 	}
 	*(*uint16)(t) = uint16(q)
 	return
@@ -505,10 +598,12 @@ func (t BarSize) Duration() time.Duration {
 	return time.Minute
 }
 
-func S2M(i interface{}) map[string]string {
-	m := make(map[string]string)
-	j, _ := json.Marshal(i)
-	_ = json.Unmarshal(j, &m)
+// Valid reports whether t is one of the trigger price types of OKX, the empty type stands for the default TriggerPxLast
+func (t TriggerPxType) Valid() bool {
+	switch t {
+	case "", TriggerPxLast, TriggerPxIndex, TriggerPxMark:
+		return true
+	}
 
-	return m
+	return false
 }
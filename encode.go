@@ -0,0 +1,284 @@
+package okx
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// EncodeQuery encodes a request into query string parameters following OKX conventions.
+//
+// Fields are named after their json tag and skipped when tagged "-" or empty with omitempty.
+// Lists are comma joined, numbers and booleans are formatted as strings and times as unix milliseconds.
+// Nested structs are not supported in a query string and make it fail.
+func EncodeQuery(i interface{}) (map[string]string, error) {
+	m := make(map[string]string)
+	if i == nil {
+		return m, nil
+	}
+	v := reflect.ValueOf(i)
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return m, nil
+		}
+		v = v.Elem()
+	}
+
+	switch v.Kind() {
+	case reflect.Map:
+		if v.Type().Key().Kind() != reflect.String {
+			return nil, fmt.Errorf("okx: unsupported query map key type %s", v.Type().Key())
+		}
+		iter := v.MapRange()
+		for iter.Next() {
+			s, err := queryValue(iter.Key().String(), iter.Value())
+			if err != nil {
+				return nil, err
+			}
+			m[iter.Key().String()] = s
+		}
+	case reflect.Struct:
+		err := walkFields(v, func(name string, opts tagOptions, fv reflect.Value) error {
+			s, err := queryValue(name, fv)
+			if err != nil {
+				return err
+			}
+			m[name] = s
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("okx: unsupported query type %s", v.Type())
+	}
+
+	return m, nil
+}
+
+// EncodeBody encodes a request, or a list of requests, into a JSON body following OKX conventions.
+//
+// Fields are named after their json tag and skipped when tagged "-" or empty with omitempty.
+// Numbers are string encoded, booleans are kept as JSON booleans, times are unix milliseconds strings,
+// lists are JSON arrays (unless the field is tagged `okx:"join"`, then they are comma joined) and structs are objects.
+func EncodeBody(i interface{}) (interface{}, error) {
+	if i == nil {
+		return map[string]interface{}{}, nil
+	}
+
+	return bodyValue("body", reflect.ValueOf(i))
+}
+
+// S2M converts a request into a map of strings.
+//
+// Deprecated: use EncodeQuery, S2M ignores encoding errors.
+func S2M(i interface{}) map[string]string {
+	m, err := EncodeQuery(i)
+	if err != nil {
+		return make(map[string]string)
+	}
+
+	return m
+}
+
+type tagOptions struct {
+	omitEmpty bool
+	join      bool
+}
+
+var (
+	jsonTimeType = reflect.TypeOf(JSONTime{})
+	timeType     = reflect.TypeOf(time.Time{})
+)
+
+// walkFields calls fn with the name and the value of every field to encode, embedded structs are flattened
+func walkFields(v reflect.Value, fn func(name string, opts tagOptions, fv reflect.Value) error) error {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		fv := v.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, rest := tag, ""
+		if idx := strings.Index(tag, ","); idx != -1 {
+			name, rest = tag[:idx], tag[idx+1:]
+		}
+		opts := tagOptions{join: f.Tag.Get("okx") == "join"}
+		for _, o := range strings.Split(rest, ",") {
+			if o == "omitempty" {
+				opts.omitEmpty = true
+			}
+		}
+
+		if f.Anonymous && name == "" {
+			ev := fv
+			if ev.Kind() == reflect.Ptr {
+				if ev.IsNil() {
+					continue
+				}
+				ev = ev.Elem()
+			}
+			if ev.Kind() == reflect.Struct {
+				if err := walkFields(ev, fn); err != nil {
+					return err
+				}
+				continue
+			}
+		}
+		if f.PkgPath != "" {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		if fv.Kind() == reflect.Ptr || fv.Kind() == reflect.Interface {
+			if fv.IsNil() {
+				continue
+			}
+		}
+		if opts.omitEmpty && isEmpty(fv) {
+			continue
+		}
+		if err := fn(name, opts, fv); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func queryValue(name string, v reflect.Value) (string, error) {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return "", nil
+		}
+		v = v.Elem()
+	}
+	if s, ok := scalar(v); ok {
+		return s, nil
+	}
+
+	switch v.Kind() {
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), nil
+	case reflect.Slice, reflect.Array:
+		if v.Type().Elem().Kind() == reflect.Uint8 && v.Kind() == reflect.Slice {
+			return string(v.Bytes()), nil
+		}
+		items := make([]string, v.Len())
+		for i := range items {
+			s, err := queryValue(name, v.Index(i))
+			if err != nil {
+				return "", err
+			}
+			items[i] = s
+		}
+		return strings.Join(items, ","), nil
+	}
+
+	return "", fmt.Errorf("okx: unsupported type %s of field %s in query", v.Type(), name)
+}
+
+func bodyValue(name string, v reflect.Value) (interface{}, error) {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil, nil
+		}
+		v = v.Elem()
+	}
+	if s, ok := scalar(v); ok {
+		return s, nil
+	}
+
+	switch v.Kind() {
+	case reflect.Bool:
+		return v.Bool(), nil
+	case reflect.Slice, reflect.Array:
+		items := make([]interface{}, v.Len())
+		for i := range items {
+			item, err := bodyValue(name, v.Index(i))
+			if err != nil {
+				return nil, err
+			}
+			items[i] = item
+		}
+		return items, nil
+	case reflect.Map:
+		if v.Type().Key().Kind() != reflect.String {
+			return nil, fmt.Errorf("okx: unsupported map key type %s of field %s in body", v.Type().Key(), name)
+		}
+		m := make(map[string]interface{}, v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			item, err := bodyValue(iter.Key().String(), iter.Value())
+			if err != nil {
+				return nil, err
+			}
+			m[iter.Key().String()] = item
+		}
+		return m, nil
+	case reflect.Struct:
+		m := make(map[string]interface{})
+		err := walkFields(v, func(name string, opts tagOptions, fv reflect.Value) error {
+			if opts.join {
+				s, err := queryValue(name, fv)
+				if err != nil {
+					return err
+				}
+				m[name] = s
+				return nil
+			}
+			item, err := bodyValue(name, fv)
+			if err != nil {
+				return err
+			}
+			m[name] = item
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		return m, nil
+	}
+
+	return nil, fmt.Errorf("okx: unsupported type %s of field %s in body", v.Type(), name)
+}
+
+// scalar formats strings, numbers and times the way OKX expects them
+func scalar(v reflect.Value) (string, bool) {
+	switch v.Type() {
+	case jsonTimeType:
+		return strconv.FormatInt(v.Convert(timeType).Interface().(time.Time).UnixMilli(), 10), true
+	case timeType:
+		return strconv.FormatInt(v.Interface().(time.Time).UnixMilli(), 10), true
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10), true
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'f', -1, 64), true
+	}
+
+	return "", false
+}
+
+func isEmpty(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Slice, reflect.Map, reflect.Array, reflect.String:
+		return v.Len() == 0
+	}
+	if v.Type() == jsonTimeType || v.Type() == timeType {
+		return v.Convert(timeType).Interface().(time.Time).IsZero()
+	}
+
+	return v.IsZero()
+}
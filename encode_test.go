@@ -0,0 +1,182 @@
+package okx
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+type (
+	encodeInner struct {
+		Ccy string `json:"ccy"`
+	}
+	encodeEmbedded struct {
+		Uly string `json:"uly,omitempty"`
+	}
+	encodeRequest struct {
+		InstID  string        `json:"instId"`
+		OrdID   string        `json:"ordId,omitempty"`
+		Sz      float64       `json:"sz,string"`
+		Px      float64       `json:"px,omitempty,string"`
+		Limit   int64         `json:"limit,omitempty,string"`
+		Reduce  bool          `json:"reduceOnly"`
+		Cxl     bool          `json:"cxlOnFail,omitempty"`
+		After   time.Time     `json:"after,omitempty"`
+		Begin   JSONTime      `json:"begin,omitempty"`
+		InstIDs []string      `json:"instIds,omitempty" okx:"join"`
+		Ccys    []string      `json:"ccys,omitempty"`
+		Inner   *encodeInner  `json:"inner,omitempty"`
+		Ignored string        `json:"-"`
+		Side    OrderSide     `json:"side,omitempty"`
+		Any     interface{}   `json:"any,omitempty"`
+		Nested  []encodeInner `json:"nested,omitempty"`
+		encodeEmbedded
+		unexport string
+	}
+)
+
+var encodeTime = time.Date(2024, 1, 2, 3, 4, 5, 6e6, time.UTC)
+
+func TestEncodeQuery(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     interface{}
+		want    map[string]string
+		wantErr bool
+	}{
+		{
+			name: "omitempty",
+			req:  encodeRequest{InstID: "BTC-USDT"},
+			want: map[string]string{"instId": "BTC-USDT", "sz": "0", "reduceOnly": "false"},
+		},
+		{
+			name: "scalars",
+			req: encodeRequest{
+				InstID: "BTC-USDT", OrdID: "1", Sz: 0.001, Px: 42000.5, Limit: 100, Reduce: true, Cxl: true,
+				After: encodeTime, Begin: JSONTime(encodeTime), Side: OrderBuy, Ignored: "x", unexport: "y",
+			},
+			want: map[string]string{
+				"instId": "BTC-USDT", "ordId": "1", "sz": "0.001", "px": "42000.5", "limit": "100",
+				"reduceOnly": "true", "cxlOnFail": "true", "after": "1704164645006", "begin": "1704164645006", "side": "buy",
+			},
+		},
+		{
+			name: "lists and embedded structs",
+			req: &encodeRequest{
+				InstID: "BTC-USDT", InstIDs: []string{"BTC-USDT", "ETH-USDT"}, Ccys: []string{"BTC", "ETH"},
+				encodeEmbedded: encodeEmbedded{Uly: "BTC-USD"},
+			},
+			want: map[string]string{
+				"instId": "BTC-USDT", "sz": "0", "reduceOnly": "false",
+				"instIds": "BTC-USDT,ETH-USDT", "ccys": "BTC,ETH", "uly": "BTC-USD",
+			},
+		},
+		{
+			name: "map",
+			req:  map[string]interface{}{"instId": "BTC-USDT", "limit": 5},
+			want: map[string]string{"instId": "BTC-USDT", "limit": "5"},
+		},
+		{
+			name: "nil",
+			req:  nil,
+			want: map[string]string{},
+		},
+		{
+			name: "nil pointer",
+			req:  (*encodeRequest)(nil),
+			want: map[string]string{},
+		},
+		{
+			name:    "nested struct",
+			req:     encodeRequest{Inner: &encodeInner{Ccy: "BTC"}},
+			wantErr: true,
+		},
+		{
+			name:    "unsupported type",
+			req:     42,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := EncodeQuery(tt.req)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("EncodeQuery() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("EncodeQuery() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEncodeBody(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     interface{}
+		want    interface{}
+		wantErr bool
+	}{
+		{
+			name: "omitempty",
+			req:  encodeRequest{InstID: "BTC-USDT"},
+			want: map[string]interface{}{"instId": "BTC-USDT", "sz": "0", "reduceOnly": false},
+		},
+		{
+			name: "scalars",
+			req: encodeRequest{
+				InstID: "BTC-USDT", Sz: 1e-8, Px: 1e21, Limit: 100, Reduce: true, Cxl: true,
+				After: encodeTime, Begin: JSONTime(encodeTime), Any: 2.5,
+			},
+			want: map[string]interface{}{
+				"instId": "BTC-USDT", "sz": "0.00000001", "px": "1000000000000000000000", "limit": "100",
+				"reduceOnly": true, "cxlOnFail": true, "after": "1704164645006", "begin": "1704164645006", "any": "2.5",
+			},
+		},
+		{
+			name: "join, lists and objects",
+			req: encodeRequest{
+				InstID: "BTC-USDT", InstIDs: []string{"BTC-USDT", "ETH-USDT"}, Ccys: []string{"BTC"},
+				Inner: &encodeInner{Ccy: "USDT"}, Nested: []encodeInner{{Ccy: "ETH"}},
+				encodeEmbedded: encodeEmbedded{Uly: "BTC-USD"},
+			},
+			want: map[string]interface{}{
+				"instId": "BTC-USDT", "sz": "0", "reduceOnly": false,
+				"instIds": "BTC-USDT,ETH-USDT", "ccys": []interface{}{"BTC"},
+				"inner": map[string]interface{}{"ccy": "USDT"}, "nested": []interface{}{map[string]interface{}{"ccy": "ETH"}},
+				"uly": "BTC-USD",
+			},
+		},
+		{
+			name: "list of requests",
+			req:  []encodeInner{{Ccy: "BTC"}, {Ccy: "ETH"}},
+			want: []interface{}{map[string]interface{}{"ccy": "BTC"}, map[string]interface{}{"ccy": "ETH"}},
+		},
+		{
+			name: "nil",
+			req:  nil,
+			want: map[string]interface{}{},
+		},
+		{
+			name:    "unsupported map key",
+			req:     map[int]string{1: "a"},
+			wantErr: true,
+		},
+		{
+			name:    "unsupported type",
+			req:     encodeRequest{Any: make(chan int)},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := EncodeBody(tt.req)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("EncodeBody() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("EncodeBody() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
@@ -36,6 +36,10 @@ type (
 		Arg   *Argument     `json:"arg,omitempty"`
 		Data  []*Argument   `json:"data,omitempty"`
 		ID    string        `json:"id,omitempty"`
+		// Channel, Raw and Err are only set on decode errors
+		Channel string `json:"-"`
+		Raw     []byte `json:"-"`
+		Err     error  `json:"-"`
 	}
 	Login struct {
 		Event string `json:"event"`
@@ -50,8 +54,43 @@ type (
 		Event string    `json:"event"`
 		Arg   *Argument `json:"arg"`
 	}
+	Raw struct {
+		Arg    *Argument         `json:"arg"`
+		Action string            `json:"action,omitempty"`
+		Data   []json.RawMessage `json:"data"`
+	}
 )
 
+// UnmarshalJSON decodes code whether it's a string, a number or empty
+func (b *Basic) UnmarshalJSON(buf []byte) error {
+	type basic Basic
+	aux := struct {
+		*basic
+		Code okx.JSONInt64 `json:"code,omitempty"`
+	}{basic: (*basic)(b)}
+	if err := json.Unmarshal(buf, &aux); err != nil {
+		return err
+	}
+	b.Code = int(aux.Code)
+
+	return nil
+}
+
+// UnmarshalJSON decodes code whether it's a string, a number or empty
+func (s *Success) UnmarshalJSON(buf []byte) error {
+	type success Success
+	aux := struct {
+		*success
+		Code okx.JSONInt64 `json:"code,omitempty"`
+	}{success: (*success)(s)}
+	if err := json.Unmarshal(buf, &aux); err != nil {
+		return err
+	}
+	s.Code = int(aux.Code)
+
+	return nil
+}
+
 func (a *Argument) Get(k string) (interface{}, bool) {
 	v, ok := a.arg[k]
 	return v, ok
@@ -3,7 +3,11 @@ package private
 import (
 	"github.com/liuhengloveyou/okx-go/events"
 	"github.com/liuhengloveyou/okx-go/models/account"
+	"github.com/liuhengloveyou/okx-go/models/blocktrading"
+	"github.com/liuhengloveyou/okx-go/models/copytrading"
+	"github.com/liuhengloveyou/okx-go/models/spread"
 	"github.com/liuhengloveyou/okx-go/models/trade"
+	"github.com/liuhengloveyou/okx-go/models/tradingbot"
 )
 
 type (
@@ -27,4 +31,40 @@ type (
 		Arg    *events.Argument   `json:"arg"`
 		Orders []*trade.AlgoOrder `json:"data"`
 	}
+	SpreadOrders struct {
+		Arg    *events.Argument `json:"arg"`
+		Orders []*spread.Order  `json:"data"`
+	}
+	SpreadTrades struct {
+		Arg    *events.Argument `json:"arg"`
+		Trades []*spread.Trade  `json:"data"`
+	}
+	RFQs struct {
+		Arg  *events.Argument    `json:"arg"`
+		RFQs []*blocktrading.RFQ `json:"data"`
+	}
+	Quotes struct {
+		Arg    *events.Argument      `json:"arg"`
+		Quotes []*blocktrading.Quote `json:"data"`
+	}
+	BlockTrades struct {
+		Arg    *events.Argument           `json:"arg"`
+		Trades []*blocktrading.BlockTrade `json:"data"`
+	}
+	GridOrders struct {
+		Arg    *events.Argument        `json:"arg"`
+		Orders []*tradingbot.GridOrder `json:"data"`
+	}
+	GridPositions struct {
+		Arg       *events.Argument           `json:"arg"`
+		Positions []*tradingbot.GridPosition `json:"data"`
+	}
+	GridSubOrders struct {
+		Arg    *events.Argument           `json:"arg"`
+		Orders []*tradingbot.GridSubOrder `json:"data"`
+	}
+	CopyTradingNotification struct {
+		Arg           *events.Argument            `json:"arg"`
+		Notifications []*copytrading.Notification `json:"data"`
+	}
 )
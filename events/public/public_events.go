@@ -4,6 +4,7 @@ import (
 	"github.com/liuhengloveyou/okx-go/events"
 	"github.com/liuhengloveyou/okx-go/models/market"
 	"github.com/liuhengloveyou/okx-go/models/publicdata"
+	"github.com/liuhengloveyou/okx-go/models/spread"
 )
 
 type (
@@ -64,4 +65,17 @@ type (
 		Arg     *events.Argument      `json:"arg"`
 		Tickers []*market.IndexTicker `json:"data"`
 	}
+	SpreadOrderBook struct {
+		Arg    *events.Argument    `json:"arg"`
+		Action string              `json:"action"`
+		Books  []*spread.OrderBook `json:"data"`
+	}
+	SpreadPublicTrades struct {
+		Arg    *events.Argument      `json:"arg"`
+		Trades []*spread.PublicTrade `json:"data"`
+	}
+	SpreadTickers struct {
+		Arg     *events.Argument `json:"arg"`
+		Tickers []*spread.Ticker `json:"data"`
+	}
 )
@@ -0,0 +1,504 @@
+// Package ledger books fills into positions per instrument and per strategy tag, and accounts for their realized
+// and unrealized PnL, fees, rebates and funding fees.
+//
+// Fills must be added in the order they happened: ws fills as they're pushed, REST ones with AddTransactionDetails.
+// api.Client.WatchLedger feeds a ledger from the websocket channels and api.Client.LoadFunding from the bills.
+package ledger
+
+import (
+	"encoding/csv"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/liuhengloveyou/okx-go"
+	"github.com/liuhengloveyou/okx-go/models/account"
+	"github.com/liuhengloveyou/okx-go/models/trade"
+)
+
+// Method is how the closing fills are matched against the opening ones
+type Method int
+
+const (
+	// FIFO closes the oldest opening fills first
+	FIFO Method = iota
+	// AverageCost closes at the average price of the position
+	AverageCost
+)
+
+const epsilon = 1e-12
+
+type (
+	// Fill is a trade booked by the ledger
+	Fill struct {
+		InstID  string
+		Tag     string
+		TradeID string
+		OrdID   string
+		Side    okx.OrderSide
+		PosSide okx.PositionSide
+		Px      float64
+		Sz      float64
+		// Fee is negative when charged and positive when it's a rebate
+		Fee    float64
+		FeeCcy string
+		Time   time.Time
+		// RealizedPnl is the PnL realized by the fill, set by the ledger
+		RealizedPnl float64
+	}
+	// Book is the position of an instrument for a tag and a position side, along with what it earned and paid.
+	//
+	// The PnL is in the settlement currency of the instrument: the quote currency for spot and linear contracts,
+	// the base currency for inverse ones.
+	Book struct {
+		InstID  string
+		Tag     string
+		PosSide okx.PositionSide
+		// Pos is negative when short
+		Pos           float64
+		AvgPx         float64
+		RealizedPnl   float64
+		UnrealizedPnl float64
+		Fees          map[string]float64 // by currency, negative
+		Rebates       map[string]float64 // by currency, positive
+		Funding       map[string]float64 // by currency, positive when received
+		Fills         int
+	}
+
+	// Ledger books fills, it's safe for concurrent use
+	Ledger struct {
+		mu        sync.Mutex
+		method    Method
+		books     map[bookKey]*book
+		contracts map[string]contract
+		marks     map[string]float64
+		fills     []Fill
+		seen      map[string]struct{}
+	}
+	bookKey struct {
+		instID  string
+		tag     string
+		posSide okx.PositionSide
+	}
+	book struct {
+		Book
+		lots []lot
+	}
+	// lot is an opening fill not closed yet, sz is negative when short
+	lot struct {
+		sz float64
+		px float64
+	}
+	contract struct {
+		ctVal   float64
+		inverse bool
+	}
+)
+
+// NewLedger returns a pointer to a fresh Ledger
+func NewLedger(method Method) *Ledger {
+	return &Ledger{
+		method:    method,
+		books:     make(map[bookKey]*book),
+		contracts: make(map[string]contract),
+		marks:     make(map[string]float64),
+		seen:      make(map[string]struct{}),
+	}
+}
+
+// SetContract set the contract value of a derivative instrument and whether it's an inverse contract,
+// the sizes of the instruments without one are in base currency
+func (l *Ledger) SetContract(instID string, ctVal float64, inverse bool) {
+	l.mu.Lock()
+	l.contracts[instID] = contract{ctVal: ctVal, inverse: inverse}
+	l.mu.Unlock()
+}
+
+// SetMarkPrice set the price the unrealized PnL of an instrument is computed at
+func (l *Ledger) SetMarkPrice(instID string, px float64) {
+	l.mu.Lock()
+	l.marks[instID] = px
+	l.mu.Unlock()
+}
+
+// Add books a fill, it returns false when a fill of the same instrument and TradeID was already booked
+func (l *Ledger) Add(f Fill) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if f.TradeID != "" {
+		key := "fill/" + f.InstID + "/" + f.TradeID
+		if _, ok := l.seen[key]; ok {
+			return false
+		}
+		l.seen[key] = struct{}{}
+	}
+
+	b := l.book(bookKey{instID: f.InstID, tag: f.Tag, posSide: f.PosSide})
+	f.RealizedPnl = l.fill(b, f)
+	b.RealizedPnl += f.RealizedPnl
+	b.Fills++
+	switch {
+	case f.Fee < 0:
+		b.Fees[f.FeeCcy] += f.Fee
+	case f.Fee > 0:
+		b.Rebates[f.FeeCcy] += f.Fee
+	}
+	l.fills = append(l.fills, f)
+
+	return true
+}
+
+// AddOrder books the fill pushed on the orders channel, if any
+func (l *Ledger) AddOrder(o *trade.Order) bool {
+	if o.TradeID == "" || o.FillSz == 0 {
+		return false
+	}
+
+	return l.Add(Fill{
+		InstID:  o.InstID,
+		Tag:     o.Tag,
+		TradeID: o.TradeID,
+		OrdID:   o.OrdID,
+		Side:    o.Side,
+		PosSide: o.PosSide,
+		Px:      float64(o.FillPx),
+		Sz:      float64(o.FillSz),
+		Fee:     float64(o.FillFee),
+		FeeCcy:  o.FillFeeCcy,
+		Time:    time.UnixMilli(int64(o.FillTime)),
+	})
+}
+
+// AddTransactionDetails books the fills of rest.Trade.GetTransactionDetails, oldest first whatever their order
+func (l *Ledger) AddTransactionDetails(details []*trade.TransactionDetail) {
+	sorted := append([]*trade.TransactionDetail{}, details...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return time.Time(sorted[i].FillTime).Before(time.Time(sorted[j].FillTime))
+	})
+	for _, d := range sorted {
+		l.Add(Fill{
+			InstID:  d.InstID,
+			Tag:     d.Tag,
+			TradeID: d.TradeID,
+			OrdID:   d.OrdID,
+			Side:    d.Side,
+			PosSide: d.PosSide,
+			Px:      float64(d.FillPx),
+			Sz:      float64(d.FillSz),
+			Fee:     float64(d.Fee),
+			FeeCcy:  d.FeeCcy,
+			Time:    time.Time(d.FillTime),
+		})
+	}
+}
+
+// AddBill books a funding fee bill of rest.Account.GetBills, it returns false for the other bills and the ones already booked.
+//
+// Bills carry no tag, so the funding fee is split between the books of the instrument by the size of their position
+// at the time of the bill, replayed from the fills booked until then, it goes to the untagged book of the instrument
+// when none was open. Bills can be added in any order, but only after the fills before them.
+func (l *Ledger) AddBill(bill *account.Bill) bool {
+	if bill.Type != okx.BillFundingFeeType {
+		return false
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	key := "bill/" + bill.BillID
+	if _, ok := l.seen[key]; ok {
+		return false
+	}
+	l.seen[key] = struct{}{}
+
+	ts := time.Time(bill.TS)
+	pos := make(map[bookKey]float64)
+	for _, f := range l.fills {
+		if f.InstID != bill.InstID || (!ts.IsZero() && f.Time.After(ts)) {
+			continue
+		}
+		k := bookKey{instID: f.InstID, tag: f.Tag, posSide: f.PosSide}
+		if f.Side == okx.OrderSell {
+			pos[k] -= f.Sz
+		} else {
+			pos[k] += f.Sz
+		}
+	}
+	var total float64
+	for k, p := range pos {
+		if math.Abs(p) <= epsilon {
+			delete(pos, k)
+			continue
+		}
+		total += math.Abs(p)
+	}
+	if len(pos) == 0 {
+		l.book(bookKey{instID: bill.InstID}).Funding[bill.Ccy] += float64(bill.BalChg)
+		return true
+	}
+	for k, p := range pos {
+		l.book(k).Funding[bill.Ccy] += float64(bill.BalChg) * math.Abs(p) / total
+	}
+
+	return true
+}
+
+// Books returns a copy of the books, sorted by instrument, tag and position side
+func (l *Ledger) Books() []Book {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	res := make([]Book, 0, len(l.books))
+	for _, b := range l.books {
+		res = append(res, l.snapshot(b))
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].InstID != res[j].InstID {
+			return res[i].InstID < res[j].InstID
+		}
+		if res[i].Tag != res[j].Tag {
+			return res[i].Tag < res[j].Tag
+		}
+		return res[i].PosSide < res[j].PosSide
+	})
+
+	return res
+}
+
+// Fills returns a copy of the fills booked, in the order they were added
+func (l *Ledger) Fills() []Fill {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return append([]Fill{}, l.fills...)
+}
+
+// Sum adds up the PnL, fees, rebates, funding and fills of books, such as the ones of a tag across instruments.
+// The positions are only added up when the books are all of the same instrument, InstID and Tag are kept when they're shared.
+func Sum(books ...Book) Book {
+	res := Book{Fees: make(map[string]float64), Rebates: make(map[string]float64), Funding: make(map[string]float64)}
+	for i, b := range books {
+		if i == 0 {
+			res.InstID, res.Tag = b.InstID, b.Tag
+		}
+		if res.InstID != b.InstID {
+			res.InstID = ""
+		}
+		if res.Tag != b.Tag {
+			res.Tag = ""
+		}
+		res.Pos += b.Pos
+		res.RealizedPnl += b.RealizedPnl
+		res.UnrealizedPnl += b.UnrealizedPnl
+		res.Fills += b.Fills
+		merge(res.Fees, b.Fees)
+		merge(res.Rebates, b.Rebates)
+		merge(res.Funding, b.Funding)
+	}
+	if res.InstID == "" {
+		res.Pos = 0
+	}
+
+	return res
+}
+
+// WriteCSV writes the books as CSV with a header, the amounts by currency are written as ccy=amount separated by semicolons
+func (l *Ledger) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	_ = cw.Write([]string{"instId", "tag", "posSide", "pos", "avgPx", "realizedPnl", "unrealizedPnl", "fees", "rebates", "funding", "fills"})
+	for _, b := range l.Books() {
+		_ = cw.Write([]string{
+			b.InstID,
+			b.Tag,
+			string(b.PosSide),
+			formatFloat(b.Pos),
+			formatFloat(b.AvgPx),
+			formatFloat(b.RealizedPnl),
+			formatFloat(b.UnrealizedPnl),
+			formatAmounts(b.Fees),
+			formatAmounts(b.Rebates),
+			formatAmounts(b.Funding),
+			strconv.Itoa(b.Fills),
+		})
+	}
+	cw.Flush()
+
+	return cw.Error()
+}
+
+// WriteFillsCSV writes the fills booked as CSV with a header, times in milliseconds since the epoch
+func (l *Ledger) WriteFillsCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	_ = cw.Write([]string{"time", "instId", "tag", "tradeId", "ordId", "side", "posSide", "px", "sz", "fee", "feeCcy", "realizedPnl"})
+	for _, f := range l.Fills() {
+		ts := ""
+		if !f.Time.IsZero() {
+			ts = strconv.FormatInt(f.Time.UnixMilli(), 10)
+		}
+		_ = cw.Write([]string{
+			ts,
+			f.InstID,
+			f.Tag,
+			f.TradeID,
+			f.OrdID,
+			string(f.Side),
+			string(f.PosSide),
+			formatFloat(f.Px),
+			formatFloat(f.Sz),
+			formatFloat(f.Fee),
+			f.FeeCcy,
+			formatFloat(f.RealizedPnl),
+		})
+	}
+	cw.Flush()
+
+	return cw.Error()
+}
+
+func (l *Ledger) book(k bookKey) *book {
+	b, ok := l.books[k]
+	if !ok {
+		b = &book{Book: Book{
+			InstID:  k.instID,
+			Tag:     k.tag,
+			PosSide: k.posSide,
+			Fees:    make(map[string]float64),
+			Rebates: make(map[string]float64),
+			Funding: make(map[string]float64),
+		}}
+		l.books[k] = b
+	}
+
+	return b
+}
+
+// fill closes the lots on the other side of the fill and opens one with the rest, it returns the PnL realized
+func (l *Ledger) fill(b *book, f Fill) (pnl float64) {
+	c := l.contract(f.InstID)
+	q := f.Sz
+	if f.Side == okx.OrderSell {
+		q = -q
+	}
+	for math.Abs(q) > epsilon && len(b.lots) > 0 && (b.lots[0].sz > 0) != (q > 0) {
+		lt := &b.lots[0]
+		closed := math.Min(math.Abs(q), math.Abs(lt.sz))
+		if lt.sz < 0 {
+			closed = -closed
+		}
+		pnl += c.pnl(closed, lt.px, f.Px)
+		lt.sz -= closed
+		q += closed
+		if math.Abs(lt.sz) <= epsilon {
+			b.lots = b.lots[1:]
+		}
+	}
+	if math.Abs(q) <= epsilon {
+		return
+	}
+	if l.method == AverageCost && len(b.lots) > 0 {
+		lt := &b.lots[0]
+		lt.px = c.avg([]lot{*lt, {sz: q, px: f.Px}})
+		lt.sz += q
+		return
+	}
+	b.lots = append(b.lots, lot{sz: q, px: f.Px})
+
+	return
+}
+
+func (l *Ledger) snapshot(b *book) Book {
+	res := b.Book
+	res.Fees, res.Rebates, res.Funding = copyAmounts(b.Fees), copyAmounts(b.Rebates), copyAmounts(b.Funding)
+	res.Pos = b.pos()
+	c := l.contract(b.InstID)
+	res.AvgPx = c.avg(b.lots)
+	if mark := l.marks[b.InstID]; mark > 0 {
+		for _, lt := range b.lots {
+			res.UnrealizedPnl += c.pnl(lt.sz, lt.px, mark)
+		}
+	}
+
+	return res
+}
+
+func (l *Ledger) contract(instID string) contract {
+	c, ok := l.contracts[instID]
+	if !ok || c.ctVal <= 0 {
+		c.ctVal = 1
+	}
+
+	return c
+}
+
+func (b *book) pos() (pos float64) {
+	for _, lt := range b.lots {
+		pos += lt.sz
+	}
+
+	return
+}
+
+// pnl returns the PnL of closing sz, negative when short, opened at entry and closed at exit
+func (c contract) pnl(sz, entry, exit float64) float64 {
+	if c.inverse {
+		return sz * c.ctVal * (1/entry - 1/exit)
+	}
+
+	return sz * c.ctVal * (exit - entry)
+}
+
+// avg returns the average price of lots, weighted by size or, for inverse contracts, harmonic
+func (c contract) avg(lots []lot) float64 {
+	var sz, w float64
+	for _, lt := range lots {
+		if lt.px <= 0 {
+			continue
+		}
+		sz += math.Abs(lt.sz)
+		if c.inverse {
+			w += math.Abs(lt.sz) / lt.px
+		} else {
+			w += math.Abs(lt.sz) * lt.px
+		}
+	}
+	if sz == 0 || w == 0 {
+		return 0
+	}
+	if c.inverse {
+		return sz / w
+	}
+
+	return w / sz
+}
+
+func merge(dst, src map[string]float64) {
+	for k, v := range src {
+		dst[k] += v
+	}
+}
+
+func copyAmounts(m map[string]float64) map[string]float64 {
+	res := make(map[string]float64, len(m))
+	merge(res, m)
+
+	return res
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+func formatAmounts(m map[string]float64) string {
+	ccys := make([]string, 0, len(m))
+	for ccy := range m {
+		ccys = append(ccys, ccy)
+	}
+	sort.Strings(ccys)
+	parts := make([]string, len(ccys))
+	for i, ccy := range ccys {
+		parts[i] = ccy + "=" + formatFloat(m[ccy])
+	}
+
+	return strings.Join(parts, ";")
+}
@@ -0,0 +1,286 @@
+package ledger
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/liuhengloveyou/okx-go"
+	"github.com/liuhengloveyou/okx-go/models/account"
+)
+
+func approx(a, b float64) bool {
+	return math.Abs(a-b) <= 1e-9*math.Max(1, math.Abs(b))
+}
+
+func TestLedgerPnl(t *testing.T) {
+	type contract struct {
+		ctVal   float64
+		inverse bool
+	}
+	tests := []struct {
+		name         string
+		method       Method
+		contract     *contract
+		fills        []Fill
+		mark         float64
+		wantPos      float64
+		wantAvgPx    float64
+		wantRealized float64
+		wantUnreal   float64
+	}{
+		{
+			name:   "fifo closes the oldest lot",
+			method: FIFO,
+			fills: []Fill{
+				{Side: okx.OrderBuy, Px: 100, Sz: 1},
+				{Side: okx.OrderBuy, Px: 200, Sz: 1},
+				{Side: okx.OrderSell, Px: 300, Sz: 1},
+			},
+			mark:         250,
+			wantPos:      1,
+			wantAvgPx:    200,
+			wantRealized: 200,
+			wantUnreal:   50,
+		},
+		{
+			name:   "average cost closes at the average price",
+			method: AverageCost,
+			fills: []Fill{
+				{Side: okx.OrderBuy, Px: 100, Sz: 1},
+				{Side: okx.OrderBuy, Px: 200, Sz: 1},
+				{Side: okx.OrderSell, Px: 300, Sz: 1},
+			},
+			mark:         250,
+			wantPos:      1,
+			wantAvgPx:    150,
+			wantRealized: 150,
+			wantUnreal:   100,
+		},
+		{
+			name:   "fifo partial close across lots",
+			method: FIFO,
+			fills: []Fill{
+				{Side: okx.OrderBuy, Px: 100, Sz: 1},
+				{Side: okx.OrderBuy, Px: 200, Sz: 2},
+				{Side: okx.OrderSell, Px: 250, Sz: 2},
+			},
+			wantPos:      1,
+			wantAvgPx:    200,
+			wantRealized: 150 + 50,
+		},
+		{
+			name:   "flip from long to short",
+			method: FIFO,
+			fills: []Fill{
+				{Side: okx.OrderBuy, Px: 100, Sz: 1},
+				{Side: okx.OrderSell, Px: 150, Sz: 2},
+			},
+			mark:         100,
+			wantPos:      -1,
+			wantAvgPx:    150,
+			wantRealized: 50,
+			wantUnreal:   50,
+		},
+		{
+			name:   "average cost short",
+			method: AverageCost,
+			fills: []Fill{
+				{Side: okx.OrderSell, Px: 200, Sz: 1},
+				{Side: okx.OrderSell, Px: 100, Sz: 1},
+				{Side: okx.OrderBuy, Px: 120, Sz: 1},
+			},
+			wantPos:      -1,
+			wantAvgPx:    150,
+			wantRealized: 30,
+		},
+		{
+			name:     "linear contract value",
+			method:   FIFO,
+			contract: &contract{ctVal: 0.01},
+			fills: []Fill{
+				{Side: okx.OrderBuy, Px: 20000, Sz: 100},
+				{Side: okx.OrderSell, Px: 21000, Sz: 100},
+			},
+			wantRealized: 1000,
+		},
+		{
+			name:     "inverse contract",
+			method:   AverageCost,
+			contract: &contract{ctVal: 100, inverse: true},
+			fills: []Fill{
+				{Side: okx.OrderBuy, Px: 20000, Sz: 10},
+				{Side: okx.OrderBuy, Px: 30000, Sz: 10},
+				{Side: okx.OrderSell, Px: 25000, Sz: 10},
+			},
+			mark:         30000,
+			wantPos:      10,
+			wantAvgPx:    24000,
+			wantRealized: 1000 * (1.0/24000 - 1.0/25000),
+			wantUnreal:   1000 * (1.0/24000 - 1.0/30000),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := NewLedger(tt.method)
+			if tt.contract != nil {
+				l.SetContract("BTC-USDT", tt.contract.ctVal, tt.contract.inverse)
+			}
+			if tt.mark > 0 {
+				l.SetMarkPrice("BTC-USDT", tt.mark)
+			}
+			for _, f := range tt.fills {
+				f.InstID = "BTC-USDT"
+				l.Add(f)
+			}
+			books := l.Books()
+			if len(books) != 1 {
+				t.Fatalf("got %d books, want 1", len(books))
+			}
+			b := books[0]
+			if !approx(b.Pos, tt.wantPos) {
+				t.Errorf("Pos = %v, want %v", b.Pos, tt.wantPos)
+			}
+			if !approx(b.AvgPx, tt.wantAvgPx) {
+				t.Errorf("AvgPx = %v, want %v", b.AvgPx, tt.wantAvgPx)
+			}
+			if !approx(b.RealizedPnl, tt.wantRealized) {
+				t.Errorf("RealizedPnl = %v, want %v", b.RealizedPnl, tt.wantRealized)
+			}
+			if !approx(b.UnrealizedPnl, tt.wantUnreal) {
+				t.Errorf("UnrealizedPnl = %v, want %v", b.UnrealizedPnl, tt.wantUnreal)
+			}
+			if b.Fills != len(tt.fills) {
+				t.Errorf("Fills = %d, want %d", b.Fills, len(tt.fills))
+			}
+		})
+	}
+}
+
+func TestLedgerAdd(t *testing.T) {
+	l := NewLedger(FIFO)
+	fills := []struct {
+		fill Fill
+		want bool
+	}{
+		{fill: Fill{InstID: "BTC-USDT", TradeID: "1", Side: okx.OrderBuy, Px: 100, Sz: 1, Fee: -0.1, FeeCcy: "USDT"}, want: true},
+		{fill: Fill{InstID: "BTC-USDT", TradeID: "1", Side: okx.OrderBuy, Px: 100, Sz: 1, Fee: -0.1, FeeCcy: "USDT"}, want: false},
+		{fill: Fill{InstID: "ETH-USDT", TradeID: "1", Side: okx.OrderBuy, Px: 10, Sz: 1, Fee: -0.01, FeeCcy: "ETH"}, want: true},
+		{fill: Fill{InstID: "BTC-USDT", TradeID: "2", Side: okx.OrderSell, Px: 110, Sz: 1, Fee: 0.05, FeeCcy: "USDT"}, want: true},
+		{fill: Fill{InstID: "BTC-USDT", Side: okx.OrderBuy, Px: 100, Sz: 1}, want: true},
+		{fill: Fill{InstID: "BTC-USDT", Side: okx.OrderSell, Px: 100, Sz: 1}, want: true},
+	}
+	for i, f := range fills {
+		if got := l.Add(f.fill); got != f.want {
+			t.Errorf("Add(fill %d) = %v, want %v", i, got, f.want)
+		}
+	}
+
+	books := l.Books()
+	if len(books) != 2 {
+		t.Fatalf("got %d books, want 2", len(books))
+	}
+	btc := books[0]
+	if btc.InstID != "BTC-USDT" || btc.Fills != 4 || !approx(btc.RealizedPnl, 10) {
+		t.Errorf("BTC-USDT book = %+v, want 4 fills and a realized PnL of 10", btc)
+	}
+	if !approx(btc.Fees["USDT"], -0.1) || !approx(btc.Rebates["USDT"], 0.05) {
+		t.Errorf("BTC-USDT fees %v and rebates %v, want -0.1 and 0.05 USDT", btc.Fees, btc.Rebates)
+	}
+	if got := len(l.Fills()); got != 5 {
+		t.Errorf("len(Fills()) = %d, want 5", got)
+	}
+
+	sum := Sum(books...)
+	if sum.InstID != "" || sum.Pos != 0 || sum.Fills != 5 || !approx(sum.Fees["USDT"], -0.1) || !approx(sum.Fees["ETH"], -0.01) {
+		t.Errorf("Sum() = %+v", sum)
+	}
+}
+
+func TestLedgerAddBill(t *testing.T) {
+	funding := func(id string, chg float64) *account.Bill {
+		return &account.Bill{BillID: id, InstID: "BTC-USDT-SWAP", Ccy: "USDT", Type: okx.BillFundingFeeType, BalChg: okx.JSONFloat64(chg)}
+	}
+	at := func(h int) time.Time { return time.Date(2024, 1, 1, h, 0, 0, 0, time.UTC) }
+	fundingAt := func(id string, chg float64, h int) *account.Bill {
+		b := funding(id, chg)
+		b.TS = okx.JSONTime(at(h))
+		return b
+	}
+	tests := []struct {
+		name  string
+		fills []Fill
+		bills []*account.Bill
+		want  map[string]float64 // funding by tag
+		added []bool
+	}{
+		{
+			name:  "no open book",
+			bills: []*account.Bill{funding("1", -4)},
+			want:  map[string]float64{"": -4},
+			added: []bool{true},
+		},
+		{
+			name: "split by position",
+			fills: []Fill{
+				{Tag: "a", Side: okx.OrderBuy, Px: 100, Sz: 1},
+				{Tag: "b", Side: okx.OrderSell, Px: 100, Sz: 3},
+			},
+			bills: []*account.Bill{funding("1", -4)},
+			want:  map[string]float64{"a": -1, "b": -3},
+			added: []bool{true},
+		},
+		{
+			name: "closed books left out",
+			fills: []Fill{
+				{Tag: "a", Side: okx.OrderBuy, Px: 100, Sz: 1},
+				{Tag: "b", Side: okx.OrderBuy, Px: 100, Sz: 1},
+				{Tag: "b", Side: okx.OrderSell, Px: 100, Sz: 1},
+			},
+			bills: []*account.Bill{funding("1", 2)},
+			want:  map[string]float64{"a": 2, "b": 0},
+			added: []bool{true},
+		},
+		{
+			name: "split by position at the time of the bill",
+			fills: []Fill{
+				{Tag: "a", Side: okx.OrderBuy, Px: 100, Sz: 1, Time: at(1)},
+				{Tag: "b", Side: okx.OrderBuy, Px: 100, Sz: 1, Time: at(1)},
+				{Tag: "a", Side: okx.OrderSell, Px: 100, Sz: 1, Time: at(9)},
+				{Tag: "b", Side: okx.OrderBuy, Px: 100, Sz: 2, Time: at(9)},
+			},
+			bills: []*account.Bill{fundingAt("2", -3, 16), fundingAt("1", -2, 8), fundingAt("0", -1, 0)},
+			want:  map[string]float64{"": -1, "a": -1, "b": -4},
+			added: []bool{true, true, true},
+		},
+		{
+			name:  "duplicate and other bills",
+			bills: []*account.Bill{funding("1", -4), funding("1", -4), {BillID: "2", InstID: "BTC-USDT-SWAP", Ccy: "USDT", Type: okx.BillTradeType, BalChg: 1}},
+			want:  map[string]float64{"": -4},
+			added: []bool{true, false, false},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := NewLedger(FIFO)
+			for _, f := range tt.fills {
+				f.InstID = "BTC-USDT-SWAP"
+				l.Add(f)
+			}
+			for i, b := range tt.bills {
+				if got := l.AddBill(b); got != tt.added[i] {
+					t.Errorf("AddBill(bill %d) = %v, want %v", i, got, tt.added[i])
+				}
+			}
+			got := make(map[string]float64)
+			for _, b := range l.Books() {
+				got[b.Tag] = b.Funding["USDT"]
+			}
+			for tag, want := range tt.want {
+				if !approx(got[tag], want) {
+					t.Errorf("funding of tag %q = %v, want %v", tag, got[tag], want)
+				}
+			}
+		})
+	}
+}
@@ -251,4 +251,182 @@ type (
 		LoanAlloc        string                  `json:"loanAlloc"`
 		Records          []*InterestLimitsRecord `json:"records"`
 	}
+	IsolatedMode struct {
+		IsoMode okx.IsolatedMode `json:"isoMode"`
+	}
+	AccountLevelPrecheck struct {
+		SCode              string               `json:"sCode"`
+		CurAcctLv          okx.AccountLevel     `json:"curAcctLv"`
+		AcctLv             okx.AccountLevel     `json:"acctLv"`
+		RiskOffsetType     string               `json:"riskOffsetType"`
+		MgnBf              *PrecheckMargin      `json:"mgnBf"`
+		MgnAft             *PrecheckMargin      `json:"mgnAft"`
+		UnmatchedInfoCheck []*PrecheckUnmatched `json:"unmatchedInfoCheck"`
+		PosList            []*PrecheckPosition  `json:"posList"`
+		PosTierCheck       []*PrecheckPosTier   `json:"posTierCheck"`
+	}
+	PrecheckMargin struct {
+		Acct1mMmr     okx.JSONFloat64 `json:"acct1mMmr"`
+		MgnRatio      okx.JSONFloat64 `json:"mgnRatio"`
+		PosFroz       okx.JSONFloat64 `json:"posFroz"`
+		SpotUsedRatio okx.JSONFloat64 `json:"spotUsedRatio"`
+	}
+	PrecheckUnmatched struct {
+		Type       string   `json:"type"`
+		TotalAsset string   `json:"totalAsset"`
+		PosList    []string `json:"posList"`
+	}
+	PrecheckPosition struct {
+		PosID string          `json:"posId"`
+		Lever okx.JSONFloat64 `json:"lever"`
+	}
+	PrecheckPosTier struct {
+		InstFamily string             `json:"instFamily"`
+		InstType   okx.InstrumentType `json:"instType"`
+		Pos        okx.JSONFloat64    `json:"pos"`
+		Lever      okx.JSONFloat64    `json:"lever"`
+		MaxSz      okx.JSONFloat64    `json:"maxSz"`
+	}
+	RiskOffsetType struct {
+		Type string `json:"type"`
+	}
+	AutoRepay struct {
+		AutoRepay bool `json:"autoRepay"`
+	}
+	OptionActivation struct {
+		TS okx.JSONTime `json:"ts"`
+	}
+	MMPConfig struct {
+		InstFamily     string          `json:"instFamily"`
+		MmpFrozen      bool            `json:"mmpFrozen"`
+		MmpFrozenUntil okx.JSONTime    `json:"mmpFrozenUntil"`
+		TimeInterval   okx.JSONInt64   `json:"timeInterval"`
+		FrozenInterval okx.JSONInt64   `json:"frozenInterval"`
+		QtyLimit       okx.JSONFloat64 `json:"qtyLimit"`
+	}
+	MMPReset struct {
+		Result bool `json:"result"`
+	}
+	QuickMarginBorrowRepay struct {
+		InstID string              `json:"instId"`
+		Ccy    string              `json:"ccy"`
+		Side   okx.QuickMarginSide `json:"side"`
+		Amt    okx.JSONFloat64     `json:"amt"`
+	}
+	QuickMarginBorrowRepayRecord struct {
+		InstID      string              `json:"instId"`
+		Ccy         string              `json:"ccy"`
+		Side        okx.QuickMarginSide `json:"side"`
+		AccBorrowed okx.JSONFloat64     `json:"accBorrowed"`
+		Amt         okx.JSONFloat64     `json:"amt"`
+		RefID       string              `json:"refId"`
+		TS          okx.JSONTime        `json:"ts"`
+	}
+	PositionBuilder struct {
+		Eq           okx.JSONFloat64      `json:"eq"`
+		TotalImr     okx.JSONFloat64      `json:"totalImr"`
+		TotalMmr     okx.JSONFloat64      `json:"totalMmr"`
+		BorrowMmr    okx.JSONFloat64      `json:"borrowMmr"`
+		DerivMmr     okx.JSONFloat64      `json:"derivMmr"`
+		MarginRatio  okx.JSONFloat64      `json:"marginRatio"`
+		Upl          okx.JSONFloat64      `json:"upl"`
+		AcctLever    okx.JSONFloat64      `json:"acctLever"`
+		Assets       []*SimulatedAsset    `json:"assets"`
+		RiskUnitData []*SimulatedRiskUnit `json:"riskUnitData"`
+		Positions    []*SimulatedPosition `json:"positions"`
+		TS           okx.JSONTime         `json:"ts"`
+	}
+	SimulatedAsset struct {
+		Ccy       string          `json:"ccy"`
+		AvailEq   okx.JSONFloat64 `json:"availEq"`
+		SpotInUse okx.JSONFloat64 `json:"spotInUse"`
+		BorrowMmr okx.JSONFloat64 `json:"borrowMmr"`
+		BorrowImr okx.JSONFloat64 `json:"borrowImr"`
+	}
+	SimulatedRiskUnit struct {
+		RiskUnit string          `json:"riskUnit"`
+		IndexUsd okx.JSONFloat64 `json:"indexUsd"`
+		Mmr      okx.JSONFloat64 `json:"mmr"`
+		Imr      okx.JSONFloat64 `json:"imr"`
+		Upl      okx.JSONFloat64 `json:"upl"`
+		Delta    okx.JSONFloat64 `json:"delta"`
+		Gamma    okx.JSONFloat64 `json:"gamma"`
+		Theta    okx.JSONFloat64 `json:"theta"`
+		Vega     okx.JSONFloat64 `json:"vega"`
+	}
+	SimulatedPosition struct {
+		InstID      string             `json:"instId"`
+		InstType    okx.InstrumentType `json:"instType"`
+		Pos         okx.JSONFloat64    `json:"pos"`
+		AvgPx       okx.JSONFloat64    `json:"avgPx"`
+		MarkPxBal   okx.JSONFloat64    `json:"markPxBal"`
+		NotionalUsd okx.JSONFloat64    `json:"notionalUsd"`
+		Imr         okx.JSONFloat64    `json:"imr"`
+		Mmr         okx.JSONFloat64    `json:"mmr"`
+		Delta       okx.JSONFloat64    `json:"delta"`
+		Gamma       okx.JSONFloat64    `json:"gamma"`
+		Theta       okx.JSONFloat64    `json:"theta"`
+		Vega        okx.JSONFloat64    `json:"vega"`
+	}
+	Greeks struct {
+		Ccy     string          `json:"ccy"`
+		DeltaBS okx.JSONFloat64 `json:"deltaBS"`
+		DeltaPA okx.JSONFloat64 `json:"deltaPA"`
+		GammaBS okx.JSONFloat64 `json:"gammaBS"`
+		GammaPA okx.JSONFloat64 `json:"gammaPA"`
+		ThetaBS okx.JSONFloat64 `json:"thetaBS"`
+		ThetaPA okx.JSONFloat64 `json:"thetaPA"`
+		VegaBS  okx.JSONFloat64 `json:"vegaBS"`
+		VegaPA  okx.JSONFloat64 `json:"vegaPA"`
+		TS      okx.JSONTime    `json:"ts"`
+	}
+	PositionHistory struct {
+		InstType      okx.InstrumentType `json:"instType"`
+		InstID        string             `json:"instId"`
+		MgnMode       okx.MarginMode     `json:"mgnMode"`
+		Type          string             `json:"type"`
+		PosID         string             `json:"posId"`
+		PosSide       okx.PositionSide   `json:"posSide"`
+		Direction     string             `json:"direction"`
+		Ccy           string             `json:"ccy"`
+		Uly           string             `json:"uly"`
+		Lever         okx.JSONFloat64    `json:"lever"`
+		OpenAvgPx     okx.JSONFloat64    `json:"openAvgPx"`
+		CloseAvgPx    okx.JSONFloat64    `json:"closeAvgPx"`
+		OpenMaxPos    okx.JSONFloat64    `json:"openMaxPos"`
+		CloseTotalPos okx.JSONFloat64    `json:"closeTotalPos"`
+		RealizedPnl   okx.JSONFloat64    `json:"realizedPnl"`
+		Pnl           okx.JSONFloat64    `json:"pnl"`
+		PnlRatio      okx.JSONFloat64    `json:"pnlRatio"`
+		Fee           okx.JSONFloat64    `json:"fee"`
+		FundingFee    okx.JSONFloat64    `json:"fundingFee"`
+		LiqPenalty    okx.JSONFloat64    `json:"liqPenalty"`
+		TriggerPx     okx.JSONFloat64    `json:"triggerPx"`
+		CTime         okx.JSONTime       `json:"cTime"`
+		UTime         okx.JSONTime       `json:"uTime"`
+	}
+	BillsArchiveRequest struct {
+		Result bool         `json:"result"`
+		TS     okx.JSONTime `json:"ts"`
+	}
+	BillsArchive struct {
+		FileHref string       `json:"fileHref"`
+		State    string       `json:"state"`
+		TS       okx.JSONTime `json:"ts"`
+	}
+	FixedLoanBorrowingOrder struct {
+		OrdID           string          `json:"ordId"`
+		Ccy             string          `json:"ccy"`
+		Amt             okx.JSONFloat64 `json:"amt"`
+		MaxRate         okx.JSONFloat64 `json:"maxRate"`
+		Rate            okx.JSONFloat64 `json:"rate"`
+		Term            string          `json:"term"`
+		State           string          `json:"state"`
+		Reborrow        bool            `json:"reborrow"`
+		ReborrowRate    okx.JSONFloat64 `json:"reborrowRate"`
+		AccruedInterest okx.JSONFloat64 `json:"accruedInterest"`
+		ExpiryTime      okx.JSONTime    `json:"expiryTime"`
+		CTime           okx.JSONTime    `json:"cTime"`
+		UTime           okx.JSONTime    `json:"uTime"`
+	}
 )
@@ -0,0 +1,116 @@
+package blocktrading
+
+import "github.com/liuhengloveyou/okx-go"
+
+type (
+	Counterparty struct {
+		TraderName string `json:"traderName"`
+		TraderCode string `json:"traderCode"`
+		Type       string `json:"type"`
+	}
+	RFQ struct {
+		RfqID                 string       `json:"rfqId"`
+		ClRfqID               string       `json:"clRfqId"`
+		Tag                   string       `json:"tag"`
+		TraderCode            string       `json:"traderCode"`
+		Counterparties        []string     `json:"counterparties"`
+		AllowPartialExecution bool         `json:"allowPartialExecution"`
+		State                 okx.RFQState `json:"state"`
+		Legs                  []*RFQLeg    `json:"legs"`
+		ValidUntil            okx.JSONTime `json:"validUntil"`
+		CTime                 okx.JSONTime `json:"cTime"`
+		UTime                 okx.JSONTime `json:"uTime"`
+	}
+	RFQLeg struct {
+		InstID  string           `json:"instId"`
+		Ccy     string           `json:"ccy"`
+		Sz      okx.JSONFloat64  `json:"sz"`
+		TdMode  okx.TradeMode    `json:"tdMode"`
+		Side    okx.OrderSide    `json:"side"`
+		PosSide okx.PositionSide `json:"posSide"`
+		TgtCcy  okx.QuantityType `json:"tgtCcy"`
+	}
+	Quote struct {
+		QuoteID    string        `json:"quoteId"`
+		ClQuoteID  string        `json:"clQuoteId"`
+		RfqID      string        `json:"rfqId"`
+		ClRfqID    string        `json:"clRfqId"`
+		Tag        string        `json:"tag"`
+		TraderCode string        `json:"traderCode"`
+		QuoteSide  okx.OrderSide `json:"quoteSide"`
+		State      okx.RFQState  `json:"state"`
+		Legs       []*QuoteLeg   `json:"legs"`
+		ValidUntil okx.JSONTime  `json:"validUntil"`
+		CTime      okx.JSONTime  `json:"cTime"`
+		UTime      okx.JSONTime  `json:"uTime"`
+	}
+	QuoteLeg struct {
+		InstID  string           `json:"instId"`
+		Ccy     string           `json:"ccy"`
+		Sz      okx.JSONFloat64  `json:"sz"`
+		Px      okx.JSONFloat64  `json:"px"`
+		TdMode  okx.TradeMode    `json:"tdMode"`
+		Side    okx.OrderSide    `json:"side"`
+		PosSide okx.PositionSide `json:"posSide"`
+		TgtCcy  okx.QuantityType `json:"tgtCcy"`
+	}
+	CancelRFQ struct {
+		RfqID   string        `json:"rfqId"`
+		ClRfqID string        `json:"clRfqId"`
+		SMsg    string        `json:"sMsg"`
+		SCode   okx.JSONInt64 `json:"sCode"`
+	}
+	CancelQuote struct {
+		QuoteID   string        `json:"quoteId"`
+		ClQuoteID string        `json:"clQuoteId"`
+		SMsg      string        `json:"sMsg"`
+		SCode     okx.JSONInt64 `json:"sCode"`
+	}
+	CancelAll struct {
+		TriggerTime okx.JSONTime `json:"triggerTime"`
+	}
+	BlockTrade struct {
+		RfqID       string           `json:"rfqId"`
+		ClRfqID     string           `json:"clRfqId"`
+		QuoteID     string           `json:"quoteId"`
+		ClQuoteID   string           `json:"clQuoteId"`
+		BlockTdID   string           `json:"blockTdId"`
+		Tag         string           `json:"tag"`
+		TTraderCode string           `json:"tTraderCode"`
+		MTraderCode string           `json:"mTraderCode"`
+		Legs        []*BlockTradeLeg `json:"legs"`
+		CTime       okx.JSONTime     `json:"cTime"`
+	}
+	BlockTradeLeg struct {
+		InstID  string          `json:"instId"`
+		TradeID string          `json:"tradeId"`
+		FeeCcy  string          `json:"feeCcy"`
+		Sz      okx.JSONFloat64 `json:"sz"`
+		Px      okx.JSONFloat64 `json:"px"`
+		Fee     okx.JSONFloat64 `json:"fee"`
+		Side    okx.OrderSide   `json:"side"`
+	}
+	PublicBlockTrade struct {
+		BlockTdID string                 `json:"blockTdId"`
+		Strategy  string                 `json:"strategy"`
+		Legs      []*PublicBlockTradeLeg `json:"legs"`
+		CTime     okx.JSONTime           `json:"cTime"`
+	}
+	PublicBlockTradeLeg struct {
+		InstID  string          `json:"instId"`
+		TradeID string          `json:"tradeId"`
+		Sz      okx.JSONFloat64 `json:"sz"`
+		Px      okx.JSONFloat64 `json:"px"`
+		Side    okx.OrderSide   `json:"side"`
+	}
+	MMPConfig struct {
+		TimeInterval   okx.JSONInt64 `json:"timeInterval"`
+		FrozenInterval okx.JSONInt64 `json:"frozenInterval"`
+		CountLimit     okx.JSONInt64 `json:"countLimit"`
+		MMPFrozen      bool          `json:"mmpFrozen"`
+		MMPFrozenUntil okx.JSONTime  `json:"mmpFrozenUntil"`
+	}
+	MMPReset struct {
+		TS okx.JSONTime `json:"ts"`
+	}
+)
@@ -0,0 +1,93 @@
+package broker
+
+import "github.com/liuhengloveyou/okx-go"
+
+type (
+	SubAccount struct {
+		SubAcct string        `json:"subAcct"`
+		Label   string        `json:"label"`
+		UID     string        `json:"uid"`
+		AcctLv  okx.JSONInt64 `json:"acctLv"`
+		TS      okx.JSONTime  `json:"ts"`
+	}
+	SubAccountList struct {
+		TotalPage okx.JSONInt64 `json:"totalPage"`
+		Page      okx.JSONInt64 `json:"page"`
+		Details   []*SubAccount `json:"details"`
+	}
+	APIKey struct {
+		SubAcct    string       `json:"subAcct"`
+		Label      string       `json:"label"`
+		APIKey     string       `json:"apiKey"`
+		SecretKey  string       `json:"secretKey"`
+		Passphrase string       `json:"passphrase"`
+		Perm       string       `json:"perm"`
+		IP         string       `json:"ip"`
+		TS         okx.JSONTime `json:"ts"`
+	}
+	SubAccountLevel struct {
+		AcctLv okx.JSONInt64 `json:"acctLv"`
+	}
+	FeeRate struct {
+		SubAcct  string             `json:"subAcct"`
+		InstType okx.InstrumentType `json:"instType"`
+		ChgType  string             `json:"chgType"`
+		ChgTaker okx.JSONFloat64    `json:"chgTaker"`
+		ChgMaker okx.JSONFloat64    `json:"chgMaker"`
+		EffDate  string             `json:"effDate"`
+	}
+	DepositAddress struct {
+		Addr     string       `json:"addr"`
+		Tag      string       `json:"tag"`
+		Memo     string       `json:"memo"`
+		PmtID    string       `json:"pmtId"`
+		Ccy      string       `json:"ccy"`
+		Chain    string       `json:"chain"`
+		To       string       `json:"to"`
+		Selected bool         `json:"selected"`
+		TS       okx.JSONTime `json:"ts"`
+	}
+	RebateDaily struct {
+		TotalPage okx.JSONInt64        `json:"totalPage"`
+		Page      okx.JSONInt64        `json:"page"`
+		TotAmt    okx.JSONFloat64      `json:"totAmt"`
+		Details   []*RebateDailyDetail `json:"details"`
+		TS        okx.JSONTime         `json:"ts"`
+	}
+	RebateDailyDetail struct {
+		SubAcct string          `json:"subAcct"`
+		Label   string          `json:"label"`
+		UID     string          `json:"uid"`
+		Amt     okx.JSONFloat64 `json:"amt"`
+		Asset   []*RebateAsset  `json:"asset"`
+	}
+	RebateAsset struct {
+		Ccy    string          `json:"ccy"`
+		Rebate okx.JSONFloat64 `json:"rebate"`
+	}
+	RebateReport struct {
+		Type      string       `json:"type"`
+		FileHref  string       `json:"fileHref"`
+		BeginTime okx.JSONTime `json:"beginTime"`
+		EndTime   okx.JSONTime `json:"endTime"`
+		TS        okx.JSONTime `json:"ts"`
+	}
+	RebateReportRequest struct {
+		Result bool         `json:"result"`
+		TS     okx.JSONTime `json:"ts"`
+	}
+	InviteeDetail struct {
+		InviteeLv         string          `json:"inviteeLv"`
+		InviteeRebateRate okx.JSONFloat64 `json:"inviteeRebateRate"`
+		TotalCommission   okx.JSONFloat64 `json:"totalCommission"`
+		Level             string          `json:"level"`
+		DepAmt            okx.JSONFloat64 `json:"depAmt"`
+		VolMonth          okx.JSONFloat64 `json:"volMonth"`
+		AccFee            okx.JSONFloat64 `json:"accFee"`
+		Region            string          `json:"region"`
+		AffiliateCode     string          `json:"affiliateCode"`
+		JoinTime          okx.JSONTime    `json:"joinTime"`
+		FirstTradeTime    okx.JSONTime    `json:"firstTradeTime"`
+		KycTime           okx.JSONTime    `json:"kycTime"`
+	}
+)
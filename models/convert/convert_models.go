@@ -0,0 +1,49 @@
+package convert
+
+import "github.com/liuhengloveyou/okx-go"
+
+type (
+	Currency struct {
+		Ccy string          `json:"ccy"`
+		Min okx.JSONFloat64 `json:"min"`
+		Max okx.JSONFloat64 `json:"max"`
+	}
+	CurrencyPair struct {
+		InstID      string          `json:"instId"`
+		BaseCcy     string          `json:"baseCcy"`
+		QuoteCcy    string          `json:"quoteCcy"`
+		BaseCcyMax  okx.JSONFloat64 `json:"baseCcyMax"`
+		BaseCcyMin  okx.JSONFloat64 `json:"baseCcyMin"`
+		QuoteCcyMax okx.JSONFloat64 `json:"quoteCcyMax"`
+		QuoteCcyMin okx.JSONFloat64 `json:"quoteCcyMin"`
+	}
+	Quote struct {
+		QuoteID   string          `json:"quoteId"`
+		ClQReqID  string          `json:"clQReqId"`
+		BaseCcy   string          `json:"baseCcy"`
+		QuoteCcy  string          `json:"quoteCcy"`
+		RfqSzCcy  string          `json:"rfqSzCcy"`
+		Side      okx.OrderSide   `json:"side"`
+		OrigRfqSz okx.JSONFloat64 `json:"origRfqSz"`
+		RfqSz     okx.JSONFloat64 `json:"rfqSz"`
+		CnvtPx    okx.JSONFloat64 `json:"cnvtPx"`
+		BaseSz    okx.JSONFloat64 `json:"baseSz"`
+		QuoteSz   okx.JSONFloat64 `json:"quoteSz"`
+		TTLMs     okx.JSONInt64   `json:"ttlMs"`
+		QuoteTime okx.JSONTime    `json:"quoteTime"`
+	}
+	Trade struct {
+		TradeID     string          `json:"tradeId"`
+		QuoteID     string          `json:"quoteId"`
+		ClTReqID    string          `json:"clTReqId"`
+		State       string          `json:"state"`
+		InstID      string          `json:"instId"`
+		BaseCcy     string          `json:"baseCcy"`
+		QuoteCcy    string          `json:"quoteCcy"`
+		Side        okx.OrderSide   `json:"side"`
+		FillPx      okx.JSONFloat64 `json:"fillPx"`
+		FillBaseSz  okx.JSONFloat64 `json:"fillBaseSz"`
+		FillQuoteSz okx.JSONFloat64 `json:"fillQuoteSz"`
+		TS          okx.JSONTime    `json:"ts"`
+	}
+)
@@ -0,0 +1,129 @@
+package copytrading
+
+import "github.com/liuhengloveyou/okx-go"
+
+type (
+	SubPosition struct {
+		InstID           string             `json:"instId"`
+		SubPosID         string             `json:"subPosId"`
+		UniqueCode       string             `json:"uniqueCode"`
+		Ccy              string             `json:"ccy"`
+		OpenOrdID        string             `json:"openOrdId"`
+		CloseOrdID       string             `json:"closeOrdId"`
+		AlgoID           string             `json:"algoId"`
+		Type             string             `json:"type"`
+		InstType         okx.InstrumentType `json:"instType"`
+		MgnMode          okx.MarginMode     `json:"mgnMode"`
+		PosSide          okx.PositionSide   `json:"posSide"`
+		Lever            okx.JSONFloat64    `json:"lever"`
+		SubPos           okx.JSONFloat64    `json:"subPos"`
+		OpenAvgPx        okx.JSONFloat64    `json:"openAvgPx"`
+		CloseAvgPx       okx.JSONFloat64    `json:"closeAvgPx"`
+		MarkPx           okx.JSONFloat64    `json:"markPx"`
+		Margin           okx.JSONFloat64    `json:"margin"`
+		Upl              okx.JSONFloat64    `json:"upl"`
+		UplRatio         okx.JSONFloat64    `json:"uplRatio"`
+		Pnl              okx.JSONFloat64    `json:"pnl"`
+		PnlRatio         okx.JSONFloat64    `json:"pnlRatio"`
+		Fee              okx.JSONFloat64    `json:"fee"`
+		FundingFee       okx.JSONFloat64    `json:"fundingFee"`
+		ProfitSharingAmt okx.JSONFloat64    `json:"profitSharingAmt"`
+		TpTriggerPx      okx.JSONFloat64    `json:"tpTriggerPx"`
+		SlTriggerPx      okx.JSONFloat64    `json:"slTriggerPx"`
+		TpOrdPx          okx.JSONFloat64    `json:"tpOrdPx"`
+		SlOrdPx          okx.JSONFloat64    `json:"slOrdPx"`
+		OpenTime         okx.JSONTime       `json:"openTime"`
+		CloseTime        okx.JSONTime       `json:"closeTime"`
+		UTime            okx.JSONTime       `json:"uTime"`
+	}
+	SubPositionResult struct {
+		SubPosID string `json:"subPosId"`
+		Tag      string `json:"tag"`
+	}
+	LeadInstrument struct {
+		InstID  string `json:"instId"`
+		Enabled bool   `json:"enabled"`
+	}
+	ProfitSharingDetail struct {
+		ProfitSharingID  string             `json:"profitSharingId"`
+		Ccy              string             `json:"ccy"`
+		NickName         string             `json:"nickName"`
+		InstType         okx.InstrumentType `json:"instType"`
+		ProfitSharingAmt okx.JSONFloat64    `json:"profitSharingAmt"`
+		TS               okx.JSONTime       `json:"ts"`
+	}
+	TotalProfitSharing struct {
+		Ccy                   string             `json:"ccy"`
+		InstType              okx.InstrumentType `json:"instType"`
+		TotalProfitSharingAmt okx.JSONFloat64    `json:"totalProfitSharingAmt"`
+	}
+	UnrealizedProfitSharing struct {
+		Ccy                        string             `json:"ccy"`
+		NickName                   string             `json:"nickName"`
+		PortLink                   string             `json:"portLink"`
+		InstType                   okx.InstrumentType `json:"instType"`
+		UnrealizedProfitSharingAmt okx.JSONFloat64    `json:"unrealizedProfitSharingAmt"`
+		TS                         okx.JSONTime       `json:"ts"`
+	}
+	CopyTraders struct {
+		Ccy                   string          `json:"ccy"`
+		CopyTotalPnl          okx.JSONFloat64 `json:"copyTotalPnl"`
+		CopyTraderNumChg      okx.JSONInt64   `json:"copyTraderNumChg"`
+		CopyTraderNumChgRatio okx.JSONFloat64 `json:"copyTraderNumChgRatio"`
+		CopyTraders           []*CopyTrader   `json:"copyTraders"`
+	}
+	CopyTrader struct {
+		NickName      string          `json:"nickName"`
+		PortLink      string          `json:"portLink"`
+		Pnl           okx.JSONFloat64 `json:"pnl"`
+		BeginCopyTime okx.JSONTime    `json:"beginCopyTime"`
+	}
+	LeadTraders struct {
+		DataVer   string            `json:"dataVer"`
+		TotalPage okx.JSONInt64     `json:"totalPage"`
+		Ranks     []*LeadTraderRank `json:"ranks"`
+	}
+	LeadTraderRank struct {
+		UniqueCode       string          `json:"uniqueCode"`
+		NickName         string          `json:"nickName"`
+		PortLink         string          `json:"portLink"`
+		Ccy              string          `json:"ccy"`
+		TraderInsts      []string        `json:"traderInsts"`
+		AccCopyTraderNum okx.JSONInt64   `json:"accCopyTraderNum"`
+		CopyTraderNum    okx.JSONInt64   `json:"copyTraderNum"`
+		MaxCopyTraderNum okx.JSONInt64   `json:"maxCopyTraderNum"`
+		LeadDays         okx.JSONInt64   `json:"leadDays"`
+		Aum              okx.JSONFloat64 `json:"aum"`
+		CopyState        okx.JSONInt64   `json:"copyState"`
+		Pnl              okx.JSONFloat64 `json:"pnl"`
+		PnlRatio         okx.JSONFloat64 `json:"pnlRatio"`
+		WinRatio         okx.JSONFloat64 `json:"winRatio"`
+	}
+	LeadTraderStats struct {
+		Ccy               string          `json:"ccy"`
+		WinRatio          okx.JSONFloat64 `json:"winRatio"`
+		ProfitDays        okx.JSONInt64   `json:"profitDays"`
+		LossDays          okx.JSONInt64   `json:"lossDays"`
+		CurCopyTraderPnl  okx.JSONFloat64 `json:"curCopyTraderPnl"`
+		AvgSubPosNotional okx.JSONFloat64 `json:"avgSubPosNotional"`
+		InvestAmt         okx.JSONFloat64 `json:"investAmt"`
+	}
+	Notification struct {
+		InfoType    string             `json:"infoType"`
+		SubPosType  string             `json:"subPosType"`
+		InstID      string             `json:"instId"`
+		SubPosID    string             `json:"subPosId"`
+		UniqueCode  string             `json:"uniqueCode"`
+		OrdID       string             `json:"ordId"`
+		InstType    okx.InstrumentType `json:"instType"`
+		MgnMode     okx.MarginMode     `json:"mgnMode"`
+		PosSide     okx.PositionSide   `json:"posSide"`
+		Side        okx.OrderSide      `json:"side"`
+		Lever       okx.JSONFloat64    `json:"lever"`
+		AvgPx       okx.JSONFloat64    `json:"avgPx"`
+		Sz          okx.JSONFloat64    `json:"sz"`
+		TpTriggerPx okx.JSONFloat64    `json:"tpTriggerPx"`
+		SlTriggerPx okx.JSONFloat64    `json:"slTriggerPx"`
+		TS          okx.JSONTime       `json:"ts"`
+	}
+)
@@ -0,0 +1,113 @@
+package finance
+
+import "github.com/liuhengloveyou/okx-go"
+
+type (
+	SavingsBalance struct {
+		Ccy        string          `json:"ccy"`
+		Amt        okx.JSONFloat64 `json:"amt"`
+		Earnings   okx.JSONFloat64 `json:"earnings"`
+		Rate       okx.JSONFloat64 `json:"rate"`
+		LoanAmt    okx.JSONFloat64 `json:"loanAmt"`
+		PendingAmt okx.JSONFloat64 `json:"pendingAmt"`
+		RedemptAmt okx.JSONFloat64 `json:"redemptAmt"`
+	}
+	SavingsPurchaseRedemption struct {
+		Ccy  string          `json:"ccy"`
+		Amt  okx.JSONFloat64 `json:"amt"`
+		Rate okx.JSONFloat64 `json:"rate"`
+		Side okx.ActionType  `json:"side"`
+	}
+	LendingRate struct {
+		Ccy  string          `json:"ccy"`
+		Rate okx.JSONFloat64 `json:"rate"`
+	}
+	LendingHistory struct {
+		Ccy      string          `json:"ccy"`
+		Amt      okx.JSONFloat64 `json:"amt"`
+		Earnings okx.JSONFloat64 `json:"earnings"`
+		Rate     okx.JSONFloat64 `json:"rate"`
+		TS       okx.JSONTime    `json:"ts"`
+	}
+	LendingRateSummary struct {
+		Ccy       string          `json:"ccy"`
+		AvgAmt    okx.JSONFloat64 `json:"avgAmt"`
+		AvgAmtUsd okx.JSONFloat64 `json:"avgAmtUsd"`
+		AvgRate   okx.JSONFloat64 `json:"avgRate"`
+		PreRate   okx.JSONFloat64 `json:"preRate"`
+		EstRate   okx.JSONFloat64 `json:"estRate"`
+	}
+	PublicLendingRate struct {
+		Ccy  string          `json:"ccy"`
+		Amt  okx.JSONFloat64 `json:"amt"`
+		Rate okx.JSONFloat64 `json:"rate"`
+		TS   okx.JSONTime    `json:"ts"`
+	}
+	StakingOffer struct {
+		Ccy                      string            `json:"ccy"`
+		ProductID                string            `json:"productId"`
+		Protocol                 string            `json:"protocol"`
+		ProtocolType             string            `json:"protocolType"`
+		Term                     string            `json:"term"`
+		State                    string            `json:"state"`
+		Apy                      okx.JSONFloat64   `json:"apy"`
+		EarlyRedeem              bool              `json:"earlyRedeem"`
+		FastRedemptionDailyLimit okx.JSONFloat64   `json:"fastRedemptionDailyLimit"`
+		InvestData               []*StakingInvest  `json:"investData"`
+		EarningData              []*StakingEarning `json:"earningData"`
+		RedeemPeriod             []string          `json:"redeemPeriod"`
+	}
+	StakingInvest struct {
+		Ccy    string          `json:"ccy"`
+		Amt    okx.JSONFloat64 `json:"amt"`
+		Bal    okx.JSONFloat64 `json:"bal"`
+		MinAmt okx.JSONFloat64 `json:"minAmt"`
+		MaxAmt okx.JSONFloat64 `json:"maxAmt"`
+	}
+	StakingEarning struct {
+		Ccy         string          `json:"ccy"`
+		EarningType string          `json:"earningType"`
+		Earnings    okx.JSONFloat64 `json:"earnings"`
+	}
+	StakingOrderResult struct {
+		OrdID string `json:"ordId"`
+		Tag   string `json:"tag"`
+	}
+	StakingOrder struct {
+		Ccy                      string            `json:"ccy"`
+		OrdID                    string            `json:"ordId"`
+		ProductID                string            `json:"productId"`
+		State                    string            `json:"state"`
+		Protocol                 string            `json:"protocol"`
+		ProtocolType             string            `json:"protocolType"`
+		Term                     string            `json:"term"`
+		Tag                      string            `json:"tag"`
+		Apy                      okx.JSONFloat64   `json:"apy"`
+		InvestData               []*StakingInvest  `json:"investData"`
+		EarningData              []*StakingEarning `json:"earningData"`
+		PurchasedTime            okx.JSONTime      `json:"purchasedTime"`
+		RedeemedTime             okx.JSONTime      `json:"redeemedTime"`
+		EstSettlementTime        okx.JSONTime      `json:"estSettlementTime"`
+		CancelRedemptionDeadline okx.JSONTime      `json:"cancelRedemptionDeadline"`
+	}
+	CoinStakingBalance struct {
+		Ccy                   string          `json:"ccy"`
+		Amt                   okx.JSONFloat64 `json:"amt"`
+		LatestInterestAccrual okx.JSONFloat64 `json:"latestInterestAccrual"`
+		TotalInterestAccrual  okx.JSONFloat64 `json:"totalInterestAccrual"`
+		TS                    okx.JSONTime    `json:"ts"`
+	}
+	CoinStakingHistory struct {
+		Type             string          `json:"type"`
+		Status           string          `json:"status"`
+		Amt              okx.JSONFloat64 `json:"amt"`
+		RedeemingAmt     okx.JSONFloat64 `json:"redeemingAmt"`
+		RequestTime      okx.JSONTime    `json:"requestTime"`
+		CompletedTime    okx.JSONTime    `json:"completedTime"`
+		EstCompletedTime okx.JSONTime    `json:"estCompletedTime"`
+	}
+	CoinStakingApy struct {
+		Rate okx.JSONFloat64 `json:"rate"`
+		TS   okx.JSONTime    `json:"ts"`
+	}
+)
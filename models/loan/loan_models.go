@@ -0,0 +1,130 @@
+package loan
+
+import (
+	"fmt"
+
+	"github.com/liuhengloveyou/okx-go"
+)
+
+type (
+	BorrowCurrency struct {
+		BorrowCcy string `json:"borrowCcy"`
+	}
+	CollateralAssets struct {
+		Assets []*Asset `json:"assets"`
+	}
+	Asset struct {
+		Ccy         string          `json:"ccy"`
+		Amt         okx.JSONFloat64 `json:"amt"`
+		NotionalUsd okx.JSONFloat64 `json:"notionalUsd"`
+	}
+	MaxLoan struct {
+		BorrowCcy      string          `json:"borrowCcy"`
+		MaxLoan        okx.JSONFloat64 `json:"maxLoan"`
+		NotionalUsd    okx.JSONFloat64 `json:"notionalUsd"`
+		RemainingQuota okx.JSONFloat64 `json:"remainingQuota"`
+	}
+	MaxCollateralRedeem struct {
+		Ccy          string          `json:"ccy"`
+		MaxRedeemAmt okx.JSONFloat64 `json:"maxRedeemAmt"`
+	}
+	LoanInfo struct {
+		LoanData              []*Asset        `json:"loanData"`
+		CollateralData        []*Asset        `json:"collateralData"`
+		LoanNotionalUsd       okx.JSONFloat64 `json:"loanNotionalUsd"`
+		CollateralNotionalUsd okx.JSONFloat64 `json:"collateralNotionalUsd"`
+		RiskWarningData       *RiskWarning    `json:"riskWarningData"`
+		CurLTV                okx.JSONFloat64 `json:"curLTV"`
+		MarginCallLTV         okx.JSONFloat64 `json:"marginCallLTV"`
+		LiqLTV                okx.JSONFloat64 `json:"liqLTV"`
+	}
+	RiskWarning struct {
+		InstID string          `json:"instId"`
+		LiqPx  okx.JSONFloat64 `json:"liqPx"`
+	}
+	LoanHistory struct {
+		RefID string          `json:"refId"`
+		Type  string          `json:"type"`
+		Ccy   string          `json:"ccy"`
+		Amt   okx.JSONFloat64 `json:"amt"`
+		TS    okx.JSONTime    `json:"ts"`
+	}
+	InterestAccrued struct {
+		RefID        string          `json:"refId"`
+		Ccy          string          `json:"ccy"`
+		OrdID        string          `json:"ordId"`
+		Loan         okx.JSONFloat64 `json:"loan"`
+		Liab         okx.JSONFloat64 `json:"liab"`
+		Interest     okx.JSONFloat64 `json:"interest"`
+		InterestRate okx.JSONFloat64 `json:"interestRate"`
+		TS           okx.JSONTime    `json:"ts"`
+	}
+	VIPBorrowRepay struct {
+		Ccy   string          `json:"ccy"`
+		Side  string          `json:"side"`
+		OrdID string          `json:"ordId"`
+		State string          `json:"state"`
+		Amt   okx.JSONFloat64 `json:"amt"`
+	}
+	VIPLoanOrder struct {
+		OrdID           string          `json:"ordId"`
+		Ccy             string          `json:"ccy"`
+		State           string          `json:"state"`
+		BorrowAmt       okx.JSONFloat64 `json:"borrowAmt"`
+		CurRate         okx.JSONFloat64 `json:"curRate"`
+		DueAmt          okx.JSONFloat64 `json:"dueAmt"`
+		OrigRate        okx.JSONFloat64 `json:"origRate"`
+		RepayAmt        okx.JSONFloat64 `json:"repayAmt"`
+		NextRefreshTime okx.JSONTime    `json:"nextRefreshTime"`
+		TS              okx.JSONTime    `json:"ts"`
+	}
+	VIPLoanOrderDetail struct {
+		Ccy        string          `json:"ccy"`
+		Type       string          `json:"type"`
+		FailReason string          `json:"failReason"`
+		Amt        okx.JSONFloat64 `json:"amt"`
+		Rate       okx.JSONFloat64 `json:"rate"`
+		TS         okx.JSONTime    `json:"ts"`
+	}
+)
+
+// LTV returns the current loan-to-value ratio computed from the notional values, 0 when there is no collateral
+func (l *LoanInfo) LTV() float64 {
+	if l.CollateralNotionalUsd <= 0 {
+		return 0
+	}
+
+	return float64(l.LoanNotionalUsd) / float64(l.CollateralNotionalUsd)
+}
+
+// LTVAt returns the loan-to-value ratio the loan would have with the given USD prices per currency,
+// it fails when the price of a currency of the loan or the collateral is missing.
+//
+// Compare it to MarginCallLTV and LiqLTV to know how far a price move puts the loan from a margin call or a liquidation.
+func (l *LoanInfo) LTVAt(prices map[string]float64) (float64, error) {
+	value := func(assets []*Asset) (float64, error) {
+		var v float64
+		for _, a := range assets {
+			px, ok := prices[a.Ccy]
+			if !ok {
+				return 0, fmt.Errorf("okx: no price for %s", a.Ccy)
+			}
+			v += float64(a.Amt) * px
+		}
+		return v, nil
+	}
+
+	loan, err := value(l.LoanData)
+	if err != nil {
+		return 0, err
+	}
+	collateral, err := value(l.CollateralData)
+	if err != nil {
+		return 0, err
+	}
+	if collateral <= 0 {
+		return 0, nil
+	}
+
+	return loan / collateral, nil
+}
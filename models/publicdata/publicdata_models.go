@@ -1,6 +1,10 @@
 package publicdata
 
-import "github.com/liuhengloveyou/okx-go"
+import (
+	"encoding/json"
+
+	"github.com/liuhengloveyou/okx-go"
+)
 
 type (
 	Instrument struct {
@@ -65,6 +69,14 @@ type (
 		Premium         okx.JSONFloat64    `json:"premium"`
 		Ts              okx.JSONTime       `json:"ts"`
 	}
+	FundingRateHistory struct {
+		InstID       string             `json:"instId"`
+		InstType     okx.InstrumentType `json:"instType"`
+		Method       string             `json:"method"`
+		FundingRate  okx.JSONFloat64    `json:"fundingRate"`
+		RealizedRate okx.JSONFloat64    `json:"realizedRate"`
+		FundingTime  okx.JSONTime       `json:"fundingTime"`
+	}
 	LimitPrice struct {
 		InstID   string             `json:"instId"`
 		InstType okx.InstrumentType `json:"instType"`
@@ -181,3 +193,18 @@ type (
 		Unit   string          `json:"unit,omitempty"`
 	}
 )
+
+// UnmarshalJSON decodes loanQuotaCoef whether it's a string, a number or empty
+func (u *InterestRateAndLoanUser) UnmarshalJSON(buf []byte) error {
+	type user InterestRateAndLoanUser
+	aux := struct {
+		*user
+		LoanQuotaCoef okx.JSONInt64 `json:"loanQuotaCoef"`
+	}{user: (*user)(u)}
+	if err := json.Unmarshal(buf, &aux); err != nil {
+		return err
+	}
+	u.LoanQuotaCoef = int(aux.LoanQuotaCoef)
+
+	return nil
+}
@@ -0,0 +1,164 @@
+package spread
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/liuhengloveyou/okx-go"
+)
+
+type (
+	PlaceOrder struct {
+		OrdID   string        `json:"ordId"`
+		ClOrdID string        `json:"clOrdId"`
+		Tag     string        `json:"tag"`
+		SMsg    string        `json:"sMsg"`
+		SCode   okx.JSONInt64 `json:"sCode"`
+	}
+	CancelOrder struct {
+		OrdID   string        `json:"ordId"`
+		ClOrdID string        `json:"clOrdId"`
+		SMsg    string        `json:"sMsg"`
+		SCode   okx.JSONInt64 `json:"sCode"`
+	}
+	AmendOrder struct {
+		OrdID   string        `json:"ordId"`
+		ClOrdID string        `json:"clOrdId"`
+		ReqID   string        `json:"reqId"`
+		SMsg    string        `json:"sMsg"`
+		SCode   okx.JSONInt64 `json:"sCode"`
+	}
+	MassCancel struct {
+		Result bool `json:"result"`
+	}
+	Order struct {
+		SprdID          string          `json:"sprdId"`
+		OrdID           string          `json:"ordId"`
+		ClOrdID         string          `json:"clOrdId"`
+		Tag             string          `json:"tag"`
+		TradeID         string          `json:"tradeId"`
+		CancelSource    string          `json:"cancelSource"`
+		Px              okx.JSONFloat64 `json:"px"`
+		Sz              okx.JSONFloat64 `json:"sz"`
+		FillPx          okx.JSONFloat64 `json:"fillPx"`
+		FillSz          okx.JSONFloat64 `json:"fillSz"`
+		AccFillSz       okx.JSONFloat64 `json:"accFillSz"`
+		PendingFillSz   okx.JSONFloat64 `json:"pendingFillSz"`
+		PendingSettleSz okx.JSONFloat64 `json:"pendingSettleSz"`
+		CanceledSz      okx.JSONFloat64 `json:"canceledSz"`
+		AvgPx           okx.JSONFloat64 `json:"avgPx"`
+		Side            okx.OrderSide   `json:"side"`
+		OrdType         okx.OrderType   `json:"ordType"`
+		State           okx.OrderState  `json:"state"`
+		UTime           okx.JSONTime    `json:"uTime"`
+		CTime           okx.JSONTime    `json:"cTime"`
+	}
+	Trade struct {
+		SprdID   string            `json:"sprdId"`
+		TradeID  string            `json:"tradeId"`
+		OrdID    string            `json:"ordId"`
+		ClOrdID  string            `json:"clOrdId"`
+		Tag      string            `json:"tag"`
+		State    string            `json:"state"`
+		ExecType okx.OrderFlowType `json:"execType"`
+		Code     string            `json:"code"`
+		Msg      string            `json:"msg"`
+		FillPx   okx.JSONFloat64   `json:"fillPx"`
+		FillSz   okx.JSONFloat64   `json:"fillSz"`
+		Side     okx.OrderSide     `json:"side"`
+		Legs     []*TradeLeg       `json:"legs"`
+		TS       okx.JSONTime      `json:"ts"`
+	}
+	TradeLeg struct {
+		InstID  string          `json:"instId"`
+		TradeID string          `json:"tradeId"`
+		FeeCcy  string          `json:"feeCcy"`
+		Px      okx.JSONFloat64 `json:"px"`
+		Sz      okx.JSONFloat64 `json:"sz"`
+		SzCont  okx.JSONFloat64 `json:"szCont"`
+		Fee     okx.JSONFloat64 `json:"fee"`
+		Side    okx.OrderSide   `json:"side"`
+	}
+	Spread struct {
+		SprdID   string          `json:"sprdId"`
+		BaseCcy  string          `json:"baseCcy"`
+		SzCcy    string          `json:"szCcy"`
+		QuoteCcy string          `json:"quoteCcy"`
+		TickSz   okx.JSONFloat64 `json:"tickSz"`
+		MinSz    okx.JSONFloat64 `json:"minSz"`
+		LotSz    okx.JSONFloat64 `json:"lotSz"`
+		SprdType okx.SpreadType  `json:"sprdType"`
+		State    okx.SpreadState `json:"state"`
+		Legs     []*SpreadLeg    `json:"legs"`
+		ListTime okx.JSONTime    `json:"listTime"`
+		ExpTime  okx.JSONTime    `json:"expTime"`
+		UTime    okx.JSONTime    `json:"uTime"`
+	}
+	SpreadLeg struct {
+		InstID string        `json:"instId"`
+		Side   okx.OrderSide `json:"side"`
+	}
+	OrderBook struct {
+		Asks []*OrderBookEntity `json:"asks"`
+		Bids []*OrderBookEntity `json:"bids"`
+		TS   okx.JSONTime       `json:"ts"`
+	}
+	OrderBookEntity struct {
+		DepthPrice   float64
+		Size         float64
+		OrderNumbers int
+	}
+	Ticker struct {
+		SprdID  string          `json:"sprdId"`
+		Last    okx.JSONFloat64 `json:"last"`
+		LastSz  okx.JSONFloat64 `json:"lastSz"`
+		AskPx   okx.JSONFloat64 `json:"askPx"`
+		AskSz   okx.JSONFloat64 `json:"askSz"`
+		BidPx   okx.JSONFloat64 `json:"bidPx"`
+		BidSz   okx.JSONFloat64 `json:"bidSz"`
+		Open24h okx.JSONFloat64 `json:"open24h"`
+		High24h okx.JSONFloat64 `json:"high24h"`
+		Low24h  okx.JSONFloat64 `json:"low24h"`
+		Vol24h  okx.JSONFloat64 `json:"vol24h"`
+		TS      okx.JSONTime    `json:"ts"`
+	}
+	PublicTrade struct {
+		SprdID  string          `json:"sprdId"`
+		TradeID string          `json:"tradeId"`
+		Px      okx.JSONFloat64 `json:"px"`
+		Sz      okx.JSONFloat64 `json:"sz"`
+		Side    okx.OrderSide   `json:"side"`
+		TS      okx.JSONTime    `json:"ts"`
+	}
+)
+
+func (o *OrderBookEntity) UnmarshalJSON(buf []byte) error {
+	var (
+		dp, s, on string
+		err       error
+	)
+	tmp := []interface{}{&dp, &s, &on}
+	wantLen := len(tmp)
+	if err := json.Unmarshal(buf, &tmp); err != nil {
+		return err
+	}
+
+	if g, e := len(tmp), wantLen; g != e {
+		return fmt.Errorf("wrong number of fields in OrderBookEntity: %d != %d", g, e)
+	}
+	o.DepthPrice, err = strconv.ParseFloat(dp, 64)
+	if err != nil {
+		return err
+	}
+	o.Size, err = strconv.ParseFloat(s, 64)
+	if err != nil {
+		return err
+	}
+	o.OrderNumbers, err = strconv.Atoi(on)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -48,6 +48,8 @@ type (
 		FillPx       okx.JSONFloat64    `json:"fillPx"`
 		FillSz       okx.JSONFloat64    `json:"fillSz"`
 		FillTime     okx.JSONFloat64    `json:"fillTime"`
+		FillFee      okx.JSONFloat64    `json:"fillFee"`
+		FillFeeCcy   string             `json:"fillFeeCcy"`
 		AvgPx        okx.JSONFloat64    `json:"avgPx"`
 		Lever        okx.JSONFloat64    `json:"lever"`
 		TpTriggerPx  okx.JSONFloat64    `json:"tpTriggerPx"`
@@ -57,6 +59,7 @@ type (
 		Fee          okx.JSONFloat64    `json:"fee"`
 		Rebate       okx.JSONFloat64    `json:"rebate"`
 		State        okx.OrderState     `json:"state"`
+		CancelSource string             `json:"cancelSource"`
 		TdMode       okx.TradeMode      `json:"tdMode"`
 		PosSide      okx.PositionSide   `json:"posSide"`
 		Side         okx.OrderSide      `json:"side"`
@@ -72,7 +75,7 @@ type (
 		TradeID  string             `json:"tradeId"`
 		ClOrdID  string             `json:"clOrdId"`
 		BillID   string             `json:"billId"`
-		Tag      okx.JSONFloat64    `json:"tag"`
+		Tag      string             `json:"tag"`
 		FillPx   okx.JSONFloat64    `json:"fillPx"`
 		FillSz   okx.JSONFloat64    `json:"fillSz"`
 		FillPnl  string             `json:"fillPnl"`
@@ -148,9 +151,70 @@ type (
 		CTime           okx.JSONTime       `json:"cTime"`
 		TriggerTime     okx.JSONTime       `json:"triggerTime"`
 	}
+	AmendAlgoOrder struct {
+		AlgoID      string        `json:"algoId"`
+		AlgoClOrdID string        `json:"algoClOrdId"`
+		ReqID       string        `json:"reqId"`
+		SMsg        string        `json:"sMsg"`
+		SCode       okx.JSONInt64 `json:"sCode"`
+	}
+	OrderPrecheck struct {
+		Type           string          `json:"type"`
+		AdjEq          okx.JSONFloat64 `json:"adjEq"`
+		AdjEqChg       okx.JSONFloat64 `json:"adjEqChg"`
+		Imr            okx.JSONFloat64 `json:"imr"`
+		ImrChg         okx.JSONFloat64 `json:"imrChg"`
+		Mmr            okx.JSONFloat64 `json:"mmr"`
+		MmrChg         okx.JSONFloat64 `json:"mmrChg"`
+		MgnRatio       okx.JSONFloat64 `json:"mgnRatio"`
+		MgnRatioChg    okx.JSONFloat64 `json:"mgnRatioChg"`
+		AvailBal       okx.JSONFloat64 `json:"availBal"`
+		AvailBalChg    okx.JSONFloat64 `json:"availBalChg"`
+		LiqPx          okx.JSONFloat64 `json:"liqPx"`
+		LiqPxDiff      okx.JSONFloat64 `json:"liqPxDiff"`
+		LiqPxDiffRatio okx.JSONFloat64 `json:"liqPxDiffRatio"`
+		PosBal         okx.JSONFloat64 `json:"posBal"`
+		PosBalChg      okx.JSONFloat64 `json:"posBalChg"`
+	}
+	MassCancel struct {
+		Result bool `json:"result"`
+	}
+	CancelAllAfter struct {
+		Tag         string       `json:"tag"`
+		TriggerTime okx.JSONTime `json:"triggerTime"`
+		TS          okx.JSONTime `json:"ts"`
+	}
+	RepayAmount struct {
+		DebtCcy  string          `json:"debtCcy,omitempty"`
+		DebtAmt  okx.JSONFloat64 `json:"debtAmt,omitempty"`
+		RepayCcy string          `json:"repayCcy,omitempty"`
+		RepayAmt okx.JSONFloat64 `json:"repayAmt,omitempty"`
+	}
+	OneClickRepayCurrency struct {
+		DebtType  string         `json:"debtType"`
+		DebtData  []*RepayAmount `json:"debtData"`
+		RepayData []*RepayAmount `json:"repayData"`
+	}
+	OneClickRepay struct {
+		DebtCcy     string          `json:"debtCcy"`
+		RepayCcy    string          `json:"repayCcy"`
+		FillDebtSz  okx.JSONFloat64 `json:"fillDebtSz"`
+		FillRepaySz okx.JSONFloat64 `json:"fillRepaySz"`
+		Status      string          `json:"status"`
+		UTime       okx.JSONTime    `json:"uTime"`
+	}
+	FillsArchiveRequest struct {
+		Result bool         `json:"result"`
+		TS     okx.JSONTime `json:"ts"`
+	}
+	FillsArchive struct {
+		FileHref string       `json:"fileHref"`
+		State    string       `json:"state"`
+		TS       okx.JSONTime `json:"ts"`
+	}
 	FromData struct {
 		Ccy    string          `json:"fromCcy"`
-		Amount okx.JSONFloat64 `json:"fromAmt""`
+		Amount okx.JSONFloat64 `json:"fromAmt"`
 	}
 	EasyConvertListResult struct {
 		FromData []FromData `json:"fromData"`
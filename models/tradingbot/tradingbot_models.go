@@ -0,0 +1,211 @@
+package tradingbot
+
+import "github.com/liuhengloveyou/okx-go"
+
+type (
+	PlaceGridOrder struct {
+		AlgoID      string        `json:"algoId"`
+		AlgoClOrdID string        `json:"algoClOrdId"`
+		Tag         string        `json:"tag"`
+		SMsg        string        `json:"sMsg"`
+		SCode       okx.JSONInt64 `json:"sCode"`
+	}
+	GridOrder struct {
+		AlgoID              string             `json:"algoId"`
+		AlgoClOrdID         string             `json:"algoClOrdId"`
+		InstID              string             `json:"instId"`
+		Uly                 string             `json:"uly"`
+		InstFamily          string             `json:"instFamily"`
+		Tag                 string             `json:"tag"`
+		State               string             `json:"state"`
+		CancelType          string             `json:"cancelType"`
+		StopType            string             `json:"stopType"`
+		StopResult          string             `json:"stopResult"`
+		InstType            okx.InstrumentType `json:"instType"`
+		AlgoOrdType         okx.AlgoOrderType  `json:"algoOrdType"`
+		RunType             okx.GridRunType    `json:"runType"`
+		Direction           okx.GridDirection  `json:"direction"`
+		BasePos             bool               `json:"basePos"`
+		MaxPx               okx.JSONFloat64    `json:"maxPx"`
+		MinPx               okx.JSONFloat64    `json:"minPx"`
+		GridNum             okx.JSONInt64      `json:"gridNum"`
+		TpTriggerPx         okx.JSONFloat64    `json:"tpTriggerPx"`
+		SlTriggerPx         okx.JSONFloat64    `json:"slTriggerPx"`
+		TpRatio             okx.JSONFloat64    `json:"tpRatio"`
+		SlRatio             okx.JSONFloat64    `json:"slRatio"`
+		TradeNum            okx.JSONInt64      `json:"tradeNum"`
+		ArbitrageNum        okx.JSONInt64      `json:"arbitrageNum"`
+		ActiveOrdNum        okx.JSONInt64      `json:"activeOrdNum"`
+		SingleAmt           okx.JSONFloat64    `json:"singleAmt"`
+		PerMinProfitRate    okx.JSONFloat64    `json:"perMinProfitRate"`
+		PerMaxProfitRate    okx.JSONFloat64    `json:"perMaxProfitRate"`
+		RunPx               okx.JSONFloat64    `json:"runPx"`
+		TotalPnl            okx.JSONFloat64    `json:"totalPnl"`
+		GridProfit          okx.JSONFloat64    `json:"gridProfit"`
+		FloatProfit         okx.JSONFloat64    `json:"floatProfit"`
+		PnlRatio            okx.JSONFloat64    `json:"pnlRatio"`
+		AnnualizedRate      okx.JSONFloat64    `json:"annualizedRate"`
+		TotalAnnualizedRate okx.JSONFloat64    `json:"totalAnnualizedRate"`
+		Investment          okx.JSONFloat64    `json:"investment"`
+		QuoteSz             okx.JSONFloat64    `json:"quoteSz"`
+		BaseSz              okx.JSONFloat64    `json:"baseSz"`
+		Sz                  okx.JSONFloat64    `json:"sz"`
+		Lever               okx.JSONFloat64    `json:"lever"`
+		ActualLever         okx.JSONFloat64    `json:"actualLever"`
+		LiqPx               okx.JSONFloat64    `json:"liqPx"`
+		Eq                  okx.JSONFloat64    `json:"eq"`
+		OrdFrozen           okx.JSONFloat64    `json:"ordFrozen"`
+		AvailEq             okx.JSONFloat64    `json:"availEq"`
+		Fee                 okx.JSONFloat64    `json:"fee"`
+		FundingFee          okx.JSONFloat64    `json:"fundingFee"`
+		ProfitSharingRatio  okx.JSONFloat64    `json:"profitSharingRatio"`
+		TriggerParams       []*TriggerParam    `json:"triggerParams"`
+		CTime               okx.JSONTime       `json:"cTime"`
+		UTime               okx.JSONTime       `json:"uTime"`
+	}
+	TriggerParam struct {
+		TriggerAction   string          `json:"triggerAction"`
+		TriggerStrategy string          `json:"triggerStrategy"`
+		Timeframe       string          `json:"timeframe"`
+		TriggerCond     string          `json:"triggerCond"`
+		StopType        string          `json:"stopType"`
+		DelaySeconds    okx.JSONInt64   `json:"delaySeconds"`
+		TimePeriod      okx.JSONInt64   `json:"timePeriod"`
+		Thold           okx.JSONFloat64 `json:"thold"`
+		TriggerPx       okx.JSONFloat64 `json:"triggerPx"`
+		TriggerTime     okx.JSONTime    `json:"triggerTime"`
+	}
+	GridSubOrder struct {
+		AlgoID      string             `json:"algoId"`
+		AlgoClOrdID string             `json:"algoClOrdId"`
+		InstID      string             `json:"instId"`
+		GroupID     string             `json:"groupId"`
+		OrdID       string             `json:"ordId"`
+		Ccy         string             `json:"ccy"`
+		FeeCcy      string             `json:"feeCcy"`
+		Tag         string             `json:"tag"`
+		InstType    okx.InstrumentType `json:"instType"`
+		AlgoOrdType okx.AlgoOrderType  `json:"algoOrdType"`
+		TdMode      okx.TradeMode      `json:"tdMode"`
+		OrdType     okx.OrderType      `json:"ordType"`
+		State       okx.OrderState     `json:"state"`
+		Side        okx.OrderSide      `json:"side"`
+		PosSide     okx.PositionSide   `json:"posSide"`
+		Px          okx.JSONFloat64    `json:"px"`
+		Sz          okx.JSONFloat64    `json:"sz"`
+		AvgPx       okx.JSONFloat64    `json:"avgPx"`
+		AccFillSz   okx.JSONFloat64    `json:"accFillSz"`
+		Fee         okx.JSONFloat64    `json:"fee"`
+		Pnl         okx.JSONFloat64    `json:"pnl"`
+		CtVal       okx.JSONFloat64    `json:"ctVal"`
+		Lever       okx.JSONFloat64    `json:"lever"`
+		CTime       okx.JSONTime       `json:"cTime"`
+		UTime       okx.JSONTime       `json:"uTime"`
+	}
+	GridPosition struct {
+		AlgoID      string             `json:"algoId"`
+		AlgoClOrdID string             `json:"algoClOrdId"`
+		InstID      string             `json:"instId"`
+		Ccy         string             `json:"ccy"`
+		InstType    okx.InstrumentType `json:"instType"`
+		MgnMode     okx.MarginMode     `json:"mgnMode"`
+		PosSide     okx.PositionSide   `json:"posSide"`
+		Pos         okx.JSONFloat64    `json:"pos"`
+		AvgPx       okx.JSONFloat64    `json:"avgPx"`
+		Lever       okx.JSONFloat64    `json:"lever"`
+		LiqPx       okx.JSONFloat64    `json:"liqPx"`
+		MgnRatio    okx.JSONFloat64    `json:"mgnRatio"`
+		Imr         okx.JSONFloat64    `json:"imr"`
+		Mmr         okx.JSONFloat64    `json:"mmr"`
+		Upl         okx.JSONFloat64    `json:"upl"`
+		UplRatio    okx.JSONFloat64    `json:"uplRatio"`
+		Last        okx.JSONFloat64    `json:"last"`
+		MarkPx      okx.JSONFloat64    `json:"markPx"`
+		NotionalUsd okx.JSONFloat64    `json:"notionalUsd"`
+		Adl         okx.JSONInt64      `json:"adl"`
+		CTime       okx.JSONTime       `json:"cTime"`
+		UTime       okx.JSONTime       `json:"uTime"`
+	}
+	GridAIParam struct {
+		InstID             string            `json:"instId"`
+		Ccy                string            `json:"ccy"`
+		SourceCcy          string            `json:"sourceCcy"`
+		Duration           string            `json:"duration"`
+		AlgoOrdType        okx.AlgoOrderType `json:"algoOrdType"`
+		RunType            okx.GridRunType   `json:"runType"`
+		Direction          okx.GridDirection `json:"direction"`
+		GridNum            okx.JSONInt64     `json:"gridNum"`
+		MaxPx              okx.JSONFloat64   `json:"maxPx"`
+		MinPx              okx.JSONFloat64   `json:"minPx"`
+		PerMaxProfitRate   okx.JSONFloat64   `json:"perMaxProfitRate"`
+		PerMinProfitRate   okx.JSONFloat64   `json:"perMinProfitRate"`
+		PerGridProfitRatio okx.JSONFloat64   `json:"perGridProfitRatio"`
+		AnnualizedRate     okx.JSONFloat64   `json:"annualizedRate"`
+		MinInvestment      okx.JSONFloat64   `json:"minInvestment"`
+		Lever              okx.JSONFloat64   `json:"lever"`
+	}
+	MinInvestment struct {
+		MinInvestmentData []*InvestmentData `json:"minInvestmentData"`
+		SingleAmt         okx.JSONFloat64   `json:"singleAmt"`
+	}
+	InvestmentData struct {
+		Ccy string          `json:"ccy"`
+		Amt okx.JSONFloat64 `json:"amt"`
+	}
+	RecurringOrder struct {
+		AlgoID         string               `json:"algoId"`
+		AlgoClOrdID    string               `json:"algoClOrdId"`
+		StgyName       string               `json:"stgyName"`
+		State          string               `json:"state"`
+		Tag            string               `json:"tag"`
+		RecurringDay   string               `json:"recurringDay"`
+		RecurringHour  string               `json:"recurringHour"`
+		RecurringTime  string               `json:"recurringTime"`
+		TimeZone       string               `json:"timeZone"`
+		InvestmentCcy  string               `json:"investmentCcy"`
+		InstType       okx.InstrumentType   `json:"instType"`
+		AlgoOrdType    okx.AlgoOrderType    `json:"algoOrdType"`
+		Period         okx.RecurringPeriod  `json:"period"`
+		TdMode         okx.TradeMode        `json:"tdMode"`
+		Amt            okx.JSONFloat64      `json:"amt"`
+		InvestmentAmt  okx.JSONFloat64      `json:"investmentAmt"`
+		TotalPnl       okx.JSONFloat64      `json:"totalPnl"`
+		TotalAnnRate   okx.JSONFloat64      `json:"totalAnnRate"`
+		PnlRatio       okx.JSONFloat64      `json:"pnlRatio"`
+		MktCap         okx.JSONFloat64      `json:"mktCap"`
+		Cycles         okx.JSONInt64        `json:"cycles"`
+		RecurringList  []*RecurringCurrency `json:"recurringList"`
+		NextInvestTime okx.JSONTime         `json:"nextInvestTime"`
+		CTime          okx.JSONTime         `json:"cTime"`
+		UTime          okx.JSONTime         `json:"uTime"`
+	}
+	RecurringCurrency struct {
+		Ccy      string          `json:"ccy"`
+		Ratio    okx.JSONFloat64 `json:"ratio"`
+		TotalAmt okx.JSONFloat64 `json:"totalAmt"`
+		Profit   okx.JSONFloat64 `json:"profit"`
+		AvgPx    okx.JSONFloat64 `json:"avgPx"`
+		Px       okx.JSONFloat64 `json:"px"`
+	}
+	RecurringSubOrder struct {
+		AlgoID      string             `json:"algoId"`
+		AlgoClOrdID string             `json:"algoClOrdId"`
+		InstID      string             `json:"instId"`
+		OrdID       string             `json:"ordId"`
+		FeeCcy      string             `json:"feeCcy"`
+		Tag         string             `json:"tag"`
+		InstType    okx.InstrumentType `json:"instType"`
+		AlgoOrdType okx.AlgoOrderType  `json:"algoOrdType"`
+		TdMode      okx.TradeMode      `json:"tdMode"`
+		OrdType     okx.OrderType      `json:"ordType"`
+		State       okx.OrderState     `json:"state"`
+		Side        okx.OrderSide      `json:"side"`
+		Px          okx.JSONFloat64    `json:"px"`
+		Sz          okx.JSONFloat64    `json:"sz"`
+		AvgPx       okx.JSONFloat64    `json:"avgPx"`
+		AccFillSz   okx.JSONFloat64    `json:"accFillSz"`
+		Fee         okx.JSONFloat64    `json:"fee"`
+		CTime       okx.JSONTime       `json:"cTime"`
+		UTime       okx.JSONTime       `json:"uTime"`
+	}
+)
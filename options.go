@@ -0,0 +1,113 @@
+package okx
+
+import (
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+type (
+	// RequestOptions are the per-request settings applied by the REST and websocket clients
+	RequestOptions struct {
+		// Header is added to the REST request, it's ignored on websocket. The reserved headers are skipped, see ReservedHeader.
+		Header map[string]string
+		// ExpTime is the absolute time after which OKX rejects the request
+		ExpTime time.Time
+		// Expiry sets ExpTime relative to the server clock at the time the request is sent, when ExpTime is zero
+		Expiry time.Duration
+	}
+	// RequestOption sets one of the RequestOptions
+	RequestOption func(*RequestOptions)
+
+	// Clock is the local clock corrected by its offset to the server clock, the zero value has no offset
+	Clock struct {
+		offset int64
+	}
+)
+
+// WithHeader adds a header to the REST request, unless it's one of the reserved headers set by the client
+func WithHeader(key, value string) RequestOption {
+	return func(o *RequestOptions) {
+		if ReservedHeader(key) {
+			return
+		}
+		if o.Header == nil {
+			o.Header = make(map[string]string)
+		}
+		o.Header[key] = value
+	}
+}
+
+// ReservedHeader reports whether key, in any case, is a header the REST client sets itself and can't be overridden:
+// the OK-ACCESS-* authentication headers, Content-Type, x-simulated-trading and expTime
+func ReservedHeader(key string) bool {
+	k := strings.ToLower(key)
+
+	return strings.HasPrefix(k, "ok-access-") || k == "content-type" || k == "x-simulated-trading" || k == "exptime"
+}
+
+// WithExpTime has OKX reject the request when it's processed after t
+func WithExpTime(t time.Time) RequestOption {
+	return func(o *RequestOptions) {
+		o.ExpTime = t
+	}
+}
+
+// WithExpiry has OKX reject the request when it's processed more than d after it was sent, according to the server clock
+func WithExpiry(d time.Duration) RequestOption {
+	return func(o *RequestOptions) {
+		o.Expiry = d
+	}
+}
+
+// NewRequestOptions returns the RequestOptions set by opts
+func NewRequestOptions(opts ...RequestOption) RequestOptions {
+	var o RequestOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	return o
+}
+
+// ExpiresAt returns the expTime of a request sent now, or the zero time when it doesn't expire
+func (o RequestOptions) ExpiresAt(clock *Clock) time.Time {
+	if !o.ExpTime.IsZero() || o.Expiry <= 0 {
+		return o.ExpTime
+	}
+
+	return clock.Now().Add(o.Expiry)
+}
+
+// FormatExpTime formats t the way OKX expects expTime, in milliseconds since the epoch
+func FormatExpTime(t time.Time) string {
+	return strconv.FormatInt(t.UnixMilli(), 10)
+}
+
+// Now returns the current time of the server
+func (c *Clock) Now() time.Time {
+	if c == nil {
+		return time.Now()
+	}
+
+	return time.Now().Add(c.Offset())
+}
+
+// Offset returns how far the server clock is ahead of the local one
+func (c *Clock) Offset() time.Duration {
+	return time.Duration(atomic.LoadInt64(&c.offset))
+}
+
+// SetOffset set how far the server clock is ahead of the local one
+func (c *Clock) SetOffset(d time.Duration) {
+	atomic.StoreInt64(&c.offset, int64(d))
+}
+
+// Sync sets the offset from the server time ts, fetched between the local times sent and received
+func (c *Clock) Sync(ts, sent, received time.Time) time.Duration {
+	d := ts.Sub(sent.Add(received.Sub(sent) / 2))
+	c.SetOffset(d)
+
+	return d
+}
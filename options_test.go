@@ -0,0 +1,25 @@
+package okx
+
+import "testing"
+
+func TestWithHeader(t *testing.T) {
+	tests := []struct {
+		key  string
+		want bool
+	}{
+		{key: "X-Request-Id", want: true},
+		{key: "OK-ACCESS-SIGN"},
+		{key: "ok-access-key"},
+		{key: "Content-Type"},
+		{key: "X-Simulated-Trading"},
+		{key: "expTime"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			o := NewRequestOptions(WithHeader(tt.key, "1"))
+			if _, got := o.Header[tt.key]; got != tt.want {
+				t.Errorf("header %s set = %v, want %v", tt.key, got, tt.want)
+			}
+		})
+	}
+}
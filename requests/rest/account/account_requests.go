@@ -89,4 +89,102 @@ type (
 	SetAccountLevel struct {
 		AcctLv string `json:"acctLv"`
 	}
+	SetIsolatedMode struct {
+		IsoMode okx.IsolatedMode `json:"isoMode"`
+		Type    string           `json:"type"`
+	}
+	PrecheckAccountLevel struct {
+		AcctLv okx.AccountLevel `json:"acctLv"`
+	}
+	SetRiskOffsetType struct {
+		Type string `json:"type"`
+	}
+	SetAutoRepay struct {
+		AutoRepay bool `json:"autoRepay"`
+	}
+	GetMMPConfig struct {
+		InstFamily string `json:"instFamily,omitempty"`
+	}
+	SetMMPConfig struct {
+		InstFamily     string  `json:"instFamily"`
+		TimeInterval   int64   `json:"timeInterval,string"`
+		FrozenInterval int64   `json:"frozenInterval,string"`
+		QtyLimit       float64 `json:"qtyLimit,string"`
+	}
+	ResetMMP struct {
+		InstType   okx.InstrumentType `json:"instType,omitempty"`
+		InstFamily string             `json:"instFamily"`
+	}
+	QuickMarginBorrowRepay struct {
+		InstID string              `json:"instId"`
+		Ccy    string              `json:"ccy"`
+		Side   okx.QuickMarginSide `json:"side"`
+		Amt    float64             `json:"amt,string"`
+	}
+	GetQuickMarginBorrowRepayHistory struct {
+		InstID string              `json:"instId,omitempty"`
+		Ccy    string              `json:"ccy,omitempty"`
+		Side   okx.QuickMarginSide `json:"side,omitempty"`
+		After  int64               `json:"after,omitempty,string"`
+		Before int64               `json:"before,omitempty,string"`
+		Begin  int64               `json:"begin,omitempty,string"`
+		End    int64               `json:"end,omitempty,string"`
+		Limit  int64               `json:"limit,omitempty,string"`
+	}
+	SimulatedPosition struct {
+		InstID string  `json:"instId"`
+		Pos    float64 `json:"pos,string"`
+		AvgPx  float64 `json:"avgPx,omitempty,string"`
+		Lever  float64 `json:"lever,omitempty,string"`
+	}
+	SimulatedAsset struct {
+		Ccy string  `json:"ccy"`
+		Amt float64 `json:"amt,string"`
+	}
+	PositionBuilder struct {
+		AcctLv           okx.AccountLevel     `json:"acctLv,omitempty"`
+		InclRealPosAndEq bool                 `json:"inclRealPosAndEq"`
+		Lever            float64              `json:"lever,omitempty,string"`
+		GreeksType       okx.GreekType        `json:"greeksType,omitempty"`
+		IdxVol           float64              `json:"idxVol,omitempty,string"`
+		SimPos           []*SimulatedPosition `json:"simPos,omitempty"`
+		SimAsset         []*SimulatedAsset    `json:"simAsset,omitempty"`
+	}
+	GetGreeks struct {
+		Ccy string `json:"ccy,omitempty"`
+	}
+	GetPositionsHistory struct {
+		InstType okx.InstrumentType `json:"instType,omitempty"`
+		InstID   string             `json:"instId,omitempty"`
+		MgnMode  okx.MarginMode     `json:"mgnMode,omitempty"`
+		Type     string             `json:"type,omitempty"`
+		PosID    string             `json:"posId,omitempty"`
+		After    int64              `json:"after,omitempty,string"`
+		Before   int64              `json:"before,omitempty,string"`
+		Limit    int64              `json:"limit,omitempty,string"`
+	}
+	BillsArchive struct {
+		Year    string `json:"year"`
+		Quarter string `json:"quarter"`
+	}
+	PlaceFixedLoanBorrowingOrder struct {
+		Ccy          string  `json:"ccy"`
+		Amt          float64 `json:"amt,string"`
+		MaxRate      float64 `json:"maxRate,string"`
+		Term         string  `json:"term"`
+		Reborrow     bool    `json:"reborrow,omitempty"`
+		ReborrowRate float64 `json:"reborrowRate,omitempty,string"`
+	}
+	GetFixedLoanBorrowingOrders struct {
+		OrdID  string `json:"ordId,omitempty"`
+		Ccy    string `json:"ccy,omitempty"`
+		State  string `json:"state,omitempty"`
+		Term   string `json:"term,omitempty"`
+		After  int64  `json:"after,omitempty,string"`
+		Before int64  `json:"before,omitempty,string"`
+		Limit  int64  `json:"limit,omitempty,string"`
+	}
+	RepayFixedLoanBorrowingOrder struct {
+		OrdID string `json:"ordId"`
+	}
 )
@@ -0,0 +1,108 @@
+package blocktrading
+
+import "github.com/liuhengloveyou/okx-go"
+
+type (
+	CreateRFQ struct {
+		Counterparties        []string  `json:"counterparties"`
+		Anonymous             bool      `json:"anonymous,omitempty"`
+		ClRfqID               string    `json:"clRfqId,omitempty"`
+		Tag                   string    `json:"tag,omitempty"`
+		AllowPartialExecution bool      `json:"allowPartialExecution,omitempty"`
+		Legs                  []*RFQLeg `json:"legs"`
+	}
+	RFQLeg struct {
+		InstID  string           `json:"instId"`
+		TdMode  okx.TradeMode    `json:"tdMode,omitempty"`
+		Ccy     string           `json:"ccy,omitempty"`
+		Sz      float64          `json:"sz,string"`
+		Side    okx.OrderSide    `json:"side"`
+		PosSide okx.PositionSide `json:"posSide,omitempty"`
+		TgtCcy  okx.QuantityType `json:"tgtCcy,omitempty"`
+	}
+	CancelRFQ struct {
+		RfqID   string `json:"rfqId,omitempty"`
+		ClRfqID string `json:"clRfqId,omitempty"`
+	}
+	CancelBatchRFQs struct {
+		RfqIDs   []string `json:"rfqIds,omitempty"`
+		ClRfqIDs []string `json:"clRfqIds,omitempty"`
+	}
+	ExecuteQuote struct {
+		RfqID   string             `json:"rfqId"`
+		QuoteID string             `json:"quoteId"`
+		Legs    []*ExecuteQuoteLeg `json:"legs,omitempty"`
+	}
+	ExecuteQuoteLeg struct {
+		InstID string  `json:"instId"`
+		Sz     float64 `json:"sz,string"`
+	}
+	CreateQuote struct {
+		RfqID     string        `json:"rfqId"`
+		ClQuoteID string        `json:"clQuoteId,omitempty"`
+		Tag       string        `json:"tag,omitempty"`
+		Anonymous bool          `json:"anonymous,omitempty"`
+		QuoteSide okx.OrderSide `json:"quoteSide"`
+		ExpiresIn int64         `json:"expiresIn,omitempty,string"`
+		Legs      []*QuoteLeg   `json:"legs"`
+	}
+	QuoteLeg struct {
+		InstID  string           `json:"instId"`
+		TdMode  okx.TradeMode    `json:"tdMode,omitempty"`
+		Ccy     string           `json:"ccy,omitempty"`
+		Sz      float64          `json:"sz,string"`
+		Px      float64          `json:"px,string"`
+		Side    okx.OrderSide    `json:"side"`
+		PosSide okx.PositionSide `json:"posSide,omitempty"`
+		TgtCcy  okx.QuantityType `json:"tgtCcy,omitempty"`
+	}
+	CancelQuote struct {
+		QuoteID   string `json:"quoteId,omitempty"`
+		ClQuoteID string `json:"clQuoteId,omitempty"`
+		RfqID     string `json:"rfqId,omitempty"`
+	}
+	CancelBatchQuotes struct {
+		QuoteIDs   []string `json:"quoteIds,omitempty"`
+		ClQuoteIDs []string `json:"clQuoteIds,omitempty"`
+	}
+	GetRFQs struct {
+		RfqID   string       `json:"rfqId,omitempty"`
+		ClRfqID string       `json:"clRfqId,omitempty"`
+		State   okx.RFQState `json:"state,omitempty"`
+		BeginID string       `json:"beginId,omitempty"`
+		EndID   string       `json:"endId,omitempty"`
+		Limit   int64        `json:"limit,omitempty,string"`
+	}
+	GetQuotes struct {
+		RfqID     string       `json:"rfqId,omitempty"`
+		ClRfqID   string       `json:"clRfqId,omitempty"`
+		QuoteID   string       `json:"quoteId,omitempty"`
+		ClQuoteID string       `json:"clQuoteId,omitempty"`
+		State     okx.RFQState `json:"state,omitempty"`
+		BeginID   string       `json:"beginId,omitempty"`
+		EndID     string       `json:"endId,omitempty"`
+		Limit     int64        `json:"limit,omitempty,string"`
+	}
+	GetTrades struct {
+		RfqID     string `json:"rfqId,omitempty"`
+		ClRfqID   string `json:"clRfqId,omitempty"`
+		QuoteID   string `json:"quoteId,omitempty"`
+		ClQuoteID string `json:"clQuoteId,omitempty"`
+		BlockTdID string `json:"blockTdId,omitempty"`
+		BeginID   string `json:"beginId,omitempty"`
+		EndID     string `json:"endId,omitempty"`
+		BeginTs   int64  `json:"beginTs,omitempty,string"`
+		EndTs     int64  `json:"endTs,omitempty,string"`
+		Limit     int64  `json:"limit,omitempty,string"`
+	}
+	GetPublicTrades struct {
+		BeginID string `json:"beginId,omitempty"`
+		EndID   string `json:"endId,omitempty"`
+		Limit   int64  `json:"limit,omitempty,string"`
+	}
+	SetMMPConfig struct {
+		TimeInterval   int64 `json:"timeInterval,string"`
+		FrozenInterval int64 `json:"frozenInterval,string"`
+		CountLimit     int64 `json:"countLimit,string"`
+	}
+)
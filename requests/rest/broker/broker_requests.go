@@ -0,0 +1,79 @@
+package broker
+
+import "github.com/liuhengloveyou/okx-go"
+
+type (
+	CreateSubAccount struct {
+		SubAcct  string `json:"subAcct"`
+		Label    string `json:"label,omitempty"`
+		ClientIP string `json:"clientIP,omitempty"`
+	}
+	DeleteSubAccount struct {
+		SubAcct string `json:"subAcct"`
+	}
+	SubAccountInfo struct {
+		SubAcct string `json:"subAcct,omitempty"`
+		Page    int64  `json:"page,omitempty,string"`
+		Limit   int64  `json:"limit,omitempty,string"`
+	}
+	CreateAPIKey struct {
+		SubAcct    string             `json:"subAcct"`
+		Label      string             `json:"label"`
+		Passphrase string             `json:"passphrase"`
+		IP         []string           `json:"ip,omitempty" okx:"join"`
+		Perm       []okx.APIKeyAccess `json:"perm,omitempty" okx:"join"`
+	}
+	QueryAPIKey struct {
+		SubAcct string `json:"subAcct"`
+		APIKey  string `json:"apiKey,omitempty"`
+	}
+	ModifyAPIKey struct {
+		SubAcct string             `json:"subAcct"`
+		APIKey  string             `json:"apiKey"`
+		Label   string             `json:"label,omitempty"`
+		IP      []string           `json:"ip,omitempty" okx:"join"`
+		Perm    []okx.APIKeyAccess `json:"perm,omitempty" okx:"join"`
+	}
+	DeleteAPIKey struct {
+		SubAcct string `json:"subAcct"`
+		APIKey  string `json:"apiKey"`
+	}
+	SetSubAccountLevel struct {
+		SubAcct string `json:"subAcct"`
+		AcctLv  int64  `json:"acctLv,string"`
+	}
+	SetFeeRate struct {
+		SubAcct  string             `json:"subAcct"`
+		InstType okx.InstrumentType `json:"instType"`
+		ChgType  string             `json:"chgType"`
+		ChgTaker float64            `json:"chgTaker,omitempty,string"`
+		ChgMaker float64            `json:"chgMaker,omitempty,string"`
+		EffDate  string             `json:"effDate,omitempty"`
+	}
+	CreateDepositAddress struct {
+		SubAcct  string `json:"subAcct"`
+		Ccy      string `json:"ccy"`
+		Chain    string `json:"chain,omitempty"`
+		AddrType string `json:"addrType,omitempty"`
+		To       string `json:"to,omitempty"`
+	}
+	RebateDaily struct {
+		SubAcct string `json:"subAcct,omitempty"`
+		Begin   string `json:"begin"`
+		End     string `json:"end"`
+		Page    int64  `json:"page,omitempty,string"`
+		Limit   int64  `json:"limit,omitempty,string"`
+	}
+	RebateReport struct {
+		Type  string `json:"type"`
+		Begin string `json:"begin,omitempty"`
+		End   string `json:"end,omitempty"`
+	}
+	GenerateRebateReport struct {
+		Begin string `json:"begin"`
+		End   string `json:"end"`
+	}
+	InviteeDetail struct {
+		UID string `json:"uid"`
+	}
+)
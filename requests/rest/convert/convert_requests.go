@@ -0,0 +1,35 @@
+package convert
+
+import "github.com/liuhengloveyou/okx-go"
+
+type (
+	CurrencyPair struct {
+		FromCcy string `json:"fromCcy"`
+		ToCcy   string `json:"toCcy"`
+	}
+	EstimateQuote struct {
+		BaseCcy  string        `json:"baseCcy"`
+		QuoteCcy string        `json:"quoteCcy"`
+		Side     okx.OrderSide `json:"side"`
+		RfqSz    float64       `json:"rfqSz,string"`
+		RfqSzCcy string        `json:"rfqSzCcy"`
+		ClQReqID string        `json:"clQReqId,omitempty"`
+		Tag      string        `json:"tag,omitempty"`
+	}
+	Trade struct {
+		QuoteID  string        `json:"quoteId"`
+		BaseCcy  string        `json:"baseCcy"`
+		QuoteCcy string        `json:"quoteCcy"`
+		Side     okx.OrderSide `json:"side"`
+		Sz       float64       `json:"sz,string"`
+		SzCcy    string        `json:"szCcy"`
+		ClTReqID string        `json:"clTReqId,omitempty"`
+		Tag      string        `json:"tag,omitempty"`
+	}
+	History struct {
+		After  int64  `json:"after,omitempty,string"`
+		Before int64  `json:"before,omitempty,string"`
+		Limit  int64  `json:"limit,omitempty,string"`
+		Tag    string `json:"tag,omitempty"`
+	}
+)
@@ -0,0 +1,81 @@
+package copytrading
+
+import "github.com/liuhengloveyou/okx-go"
+
+type (
+	SubPositions struct {
+		InstType okx.InstrumentType `json:"instType,omitempty"`
+		InstID   string             `json:"instId,omitempty"`
+		After    string             `json:"after,omitempty"`
+		Before   string             `json:"before,omitempty"`
+		Limit    int64              `json:"limit,omitempty,string"`
+	}
+	PlaceSubPosition struct {
+		InstID  string           `json:"instId"`
+		TdMode  okx.TradeMode    `json:"tdMode"`
+		Side    okx.OrderSide    `json:"side"`
+		PosSide okx.PositionSide `json:"posSide,omitempty"`
+		OrdType okx.OrderType    `json:"ordType"`
+		Sz      float64          `json:"sz,string"`
+		Px      float64          `json:"px,omitempty,string"`
+		ClOrdID string           `json:"clOrdId,omitempty"`
+		Tag     string           `json:"tag,omitempty"`
+	}
+	SubPositionTpSl struct {
+		InstType        okx.InstrumentType `json:"instType,omitempty"`
+		SubPosID        string             `json:"subPosId"`
+		TpTriggerPx     float64            `json:"tpTriggerPx,omitempty,string"`
+		SlTriggerPx     float64            `json:"slTriggerPx,omitempty,string"`
+		TpOrdPx         float64            `json:"tpOrdPx,omitempty,string"`
+		SlOrdPx         float64            `json:"slOrdPx,omitempty,string"`
+		TpTriggerPxType string             `json:"tpTriggerPxType,omitempty"`
+		SlTriggerPxType string             `json:"slTriggerPxType,omitempty"`
+		Tag             string             `json:"tag,omitempty"`
+	}
+	CloseSubPosition struct {
+		InstType okx.InstrumentType `json:"instType,omitempty"`
+		SubPosID string             `json:"subPosId"`
+		OrdType  okx.OrderType      `json:"ordType,omitempty"`
+		Px       float64            `json:"px,omitempty,string"`
+		Tag      string             `json:"tag,omitempty"`
+	}
+	LeadInstruments struct {
+		InstType okx.InstrumentType `json:"instType,omitempty"`
+	}
+	SetLeadInstruments struct {
+		InstType okx.InstrumentType `json:"instType,omitempty"`
+		InstID   []string           `json:"instId" okx:"join"`
+	}
+	ProfitSharingDetails struct {
+		InstType okx.InstrumentType `json:"instType,omitempty"`
+		After    string             `json:"after,omitempty"`
+		Before   string             `json:"before,omitempty"`
+		Limit    int64              `json:"limit,omitempty,string"`
+	}
+	ProfitSharing struct {
+		InstType okx.InstrumentType `json:"instType,omitempty"`
+	}
+	CopyTraders struct {
+		InstType   okx.InstrumentType `json:"instType,omitempty"`
+		UniqueCode string             `json:"uniqueCode,omitempty"`
+		Limit      int64              `json:"limit,omitempty,string"`
+	}
+	LeadTraders struct {
+		InstType    okx.InstrumentType `json:"instType,omitempty"`
+		SortType    string             `json:"sortType,omitempty"`
+		State       string             `json:"state,omitempty"`
+		MinLeadDays string             `json:"minLeadDays,omitempty"`
+		MinAssets   float64            `json:"minAssets,omitempty,string"`
+		MaxAssets   float64            `json:"maxAssets,omitempty,string"`
+		MinAum      float64            `json:"minAum,omitempty,string"`
+		MaxAum      float64            `json:"maxAum,omitempty,string"`
+		DataVer     string             `json:"dataVer,omitempty"`
+		Page        int64              `json:"page,omitempty,string"`
+		Limit       int64              `json:"limit,omitempty,string"`
+	}
+	LeadTraderStats struct {
+		InstType   okx.InstrumentType `json:"instType,omitempty"`
+		UniqueCode string             `json:"uniqueCode"`
+		LastDays   string             `json:"lastDays"`
+	}
+)
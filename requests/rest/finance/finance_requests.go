@@ -0,0 +1,74 @@
+package finance
+
+import "github.com/liuhengloveyou/okx-go"
+
+type (
+	SavingsBalance struct {
+		Ccy string `json:"ccy,omitempty"`
+	}
+	SavingsPurchaseRedemption struct {
+		Ccy  string         `json:"ccy"`
+		Amt  float64        `json:"amt,string"`
+		Side okx.ActionType `json:"side"`
+		Rate float64        `json:"rate,omitempty,string"`
+	}
+	SetLendingRate struct {
+		Ccy  string  `json:"ccy"`
+		Rate float64 `json:"rate,string"`
+	}
+	LendingHistory struct {
+		Ccy    string `json:"ccy,omitempty"`
+		After  int64  `json:"after,omitempty,string"`
+		Before int64  `json:"before,omitempty,string"`
+		Limit  int64  `json:"limit,omitempty,string"`
+	}
+	LendingRateSummary struct {
+		Ccy string `json:"ccy,omitempty"`
+	}
+	StakingOffers struct {
+		ProductID    string `json:"productId,omitempty"`
+		ProtocolType string `json:"protocolType,omitempty"`
+		Ccy          string `json:"ccy,omitempty"`
+	}
+	StakingPurchase struct {
+		ProductID  string           `json:"productId"`
+		InvestData []*StakingInvest `json:"investData"`
+		Term       string           `json:"term,omitempty"`
+		Tag        string           `json:"tag,omitempty"`
+	}
+	StakingInvest struct {
+		Ccy string  `json:"ccy"`
+		Amt float64 `json:"amt,string"`
+	}
+	StakingRedeem struct {
+		OrdID            string `json:"ordId"`
+		ProtocolType     string `json:"protocolType"`
+		AllowEarlyRedeem bool   `json:"allowEarlyRedeem,omitempty"`
+	}
+	StakingCancel struct {
+		OrdID        string `json:"ordId"`
+		ProtocolType string `json:"protocolType"`
+	}
+	StakingOrders struct {
+		ProductID    string `json:"productId,omitempty"`
+		ProtocolType string `json:"protocolType,omitempty"`
+		Ccy          string `json:"ccy,omitempty"`
+		State        string `json:"state,omitempty"`
+		After        string `json:"after,omitempty"`
+		Before       string `json:"before,omitempty"`
+		Limit        int64  `json:"limit,omitempty,string"`
+	}
+	CoinStaking struct {
+		Amt float64 `json:"amt,string"`
+	}
+	CoinStakingHistory struct {
+		Type   string `json:"type"`
+		Status string `json:"status,omitempty"`
+		After  int64  `json:"after,omitempty,string"`
+		Before int64  `json:"before,omitempty,string"`
+		Limit  int64  `json:"limit,omitempty,string"`
+	}
+	CoinStakingApy struct {
+		Days int64 `json:"days,string"`
+	}
+)
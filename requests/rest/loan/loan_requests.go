@@ -0,0 +1,63 @@
+package loan
+
+type (
+	CollateralAssets struct {
+		Ccy string `json:"ccy,omitempty"`
+	}
+	MaxLoan struct {
+		BorrowCcy     string   `json:"borrowCcy"`
+		SupCollateral []*Asset `json:"supCollateral,omitempty"`
+	}
+	Asset struct {
+		Ccy string  `json:"ccy"`
+		Amt float64 `json:"amt,string"`
+	}
+	MaxCollateralRedeem struct {
+		Ccy string `json:"ccy"`
+	}
+	AdjustCollateral struct {
+		Type          string  `json:"type"`
+		CollateralCcy string  `json:"collateralCcy"`
+		CollateralAmt float64 `json:"collateralAmt,string"`
+	}
+	LoanHistory struct {
+		Type   string `json:"type,omitempty"`
+		After  int64  `json:"after,omitempty,string"`
+		Before int64  `json:"before,omitempty,string"`
+		Limit  int64  `json:"limit,omitempty,string"`
+	}
+	InterestAccrued struct {
+		Ccy    string `json:"ccy,omitempty"`
+		After  int64  `json:"after,omitempty,string"`
+		Before int64  `json:"before,omitempty,string"`
+		Limit  int64  `json:"limit,omitempty,string"`
+	}
+	VIPBorrowRepay struct {
+		Ccy   string  `json:"ccy"`
+		Side  string  `json:"side"`
+		Amt   float64 `json:"amt,string"`
+		OrdID string  `json:"ordId,omitempty"`
+	}
+	VIPInterest struct {
+		Ccy    string `json:"ccy,omitempty"`
+		OrdID  string `json:"ordId,omitempty"`
+		After  int64  `json:"after,omitempty,string"`
+		Before int64  `json:"before,omitempty,string"`
+		Limit  int64  `json:"limit,omitempty,string"`
+	}
+	VIPLoanOrders struct {
+		OrdID  string `json:"ordId,omitempty"`
+		State  string `json:"state,omitempty"`
+		Ccy    string `json:"ccy,omitempty"`
+		After  int64  `json:"after,omitempty,string"`
+		Before int64  `json:"before,omitempty,string"`
+		Limit  int64  `json:"limit,omitempty,string"`
+	}
+	VIPLoanOrderDetail struct {
+		OrdID  string `json:"ordId"`
+		Ccy    string `json:"ccy,omitempty"`
+		After  int64  `json:"after,omitempty,string"`
+		Before int64  `json:"before,omitempty,string"`
+		Limit  int64  `json:"limit,omitempty,string"`
+	}
+)
@@ -0,0 +1,73 @@
+package spread
+
+import "github.com/liuhengloveyou/okx-go"
+
+type (
+	PlaceOrder struct {
+		ID      string        `json:"-"`
+		SprdID  string        `json:"sprdId"`
+		ClOrdID string        `json:"clOrdId,omitempty"`
+		Tag     string        `json:"tag,omitempty"`
+		Side    okx.OrderSide `json:"side"`
+		OrdType okx.OrderType `json:"ordType"`
+		Sz      float64       `json:"sz,string"`
+		Px      float64       `json:"px,omitempty,string"`
+	}
+	CancelOrder struct {
+		ID      string `json:"-"`
+		OrdID   string `json:"ordId,omitempty"`
+		ClOrdID string `json:"clOrdId,omitempty"`
+	}
+	MassCancel struct {
+		ID     string `json:"-"`
+		SprdID string `json:"sprdId,omitempty"`
+	}
+	AmendOrder struct {
+		ID      string  `json:"-"`
+		OrdID   string  `json:"ordId,omitempty"`
+		ClOrdID string  `json:"clOrdId,omitempty"`
+		ReqID   string  `json:"reqId,omitempty"`
+		NewSz   float64 `json:"newSz,omitempty,string"`
+		NewPx   float64 `json:"newPx,omitempty,string"`
+	}
+	OrderDetails struct {
+		OrdID   string `json:"ordId,omitempty"`
+		ClOrdID string `json:"clOrdId,omitempty"`
+	}
+	OrderList struct {
+		SprdID  string         `json:"sprdId,omitempty"`
+		OrdType okx.OrderType  `json:"ordType,omitempty"`
+		State   okx.OrderState `json:"state,omitempty"`
+		BeginID string         `json:"beginId,omitempty"`
+		EndID   string         `json:"endId,omitempty"`
+		Begin   int64          `json:"begin,omitempty,string"`
+		End     int64          `json:"end,omitempty,string"`
+		Limit   int64          `json:"limit,omitempty,string"`
+	}
+	Trades struct {
+		SprdID  string `json:"sprdId,omitempty"`
+		TradeID string `json:"tradeId,omitempty"`
+		OrdID   string `json:"ordId,omitempty"`
+		BeginID string `json:"beginId,omitempty"`
+		EndID   string `json:"endId,omitempty"`
+		Begin   int64  `json:"begin,omitempty,string"`
+		End     int64  `json:"end,omitempty,string"`
+		Limit   int64  `json:"limit,omitempty,string"`
+	}
+	Spreads struct {
+		BaseCcy string          `json:"baseCcy,omitempty"`
+		InstID  string          `json:"instId,omitempty"`
+		SprdID  string          `json:"sprdId,omitempty"`
+		State   okx.SpreadState `json:"state,omitempty"`
+	}
+	OrderBook struct {
+		SprdID string `json:"sprdId"`
+		Sz     int64  `json:"sz,omitempty,string"`
+	}
+	Ticker struct {
+		SprdID string `json:"sprdId"`
+	}
+	PublicTrades struct {
+		SprdID string `json:"sprdId,omitempty"`
+	}
+)
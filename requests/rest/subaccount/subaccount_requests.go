@@ -15,14 +15,14 @@ type (
 		SubAcct    string           `json:"subAcct"`
 		Label      string           `json:"label"`
 		Passphrase string           `json:"Passphrase"`
-		IP         []string         `json:"ip,omitempty"`
+		IP         []string         `json:"ip,omitempty" okx:"join"`
 		Perm       okx.APIKeyAccess `json:"perm,omitempty"`
 	}
 	ResetAPIKey struct {
 		SubAcct string           `json:"subAcct"`
 		ApiKey  string           `json:"apiKey"`
 		Label   string           `json:"label"`
-		IP      []string         `json:"ip,omitempty"`
+		IP      []string         `json:"ip,omitempty" okx:"join"`
 		Perm    okx.APIKeyAccess `json:"perm,omitempty"`
 	}
 	QueryAPIKey struct {
@@ -76,7 +76,7 @@ type (
 		SubAcct    string           `json:"subAcct"`
 		Label      string           `json:"label"`
 		Passphrase string           `json:"passphrase"`
-		IP         []string         `json:"ip,omitempty"`
+		IP         []string         `json:"ip,omitempty" okx:"join"`
 		Perm       okx.APIKeyAccess `json:"perm,omitempty"`
 	}
 	UpdateAPIKEySubAccount struct {
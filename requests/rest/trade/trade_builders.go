@@ -0,0 +1,287 @@
+package trade
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/liuhengloveyou/okx-go"
+)
+
+// MarketPx is the order price of a take profit, stop loss or trigger leg executed at the market price
+const MarketPx = -1
+
+type (
+	// AlgoOrderBase are the fields shared by every algo order, the builders below fill the ones of its type
+	AlgoOrderBase struct {
+		InstID     string
+		TdMode     okx.TradeMode
+		Ccy        string
+		ClOrdID    string
+		Tag        string
+		Side       okx.OrderSide
+		PosSide    okx.PositionSide
+		Sz         float64
+		ReduceOnly bool
+		TgtCcy     okx.QuantityType
+	}
+	// Leg is a take profit or a stop loss: once the price of type TriggerPxType reaches TriggerPx,
+	// an order is placed at OrdPx, or at the market price when OrdPx is MarketPx
+	Leg struct {
+		TriggerPx     float64
+		OrdPx         float64
+		TriggerPxType okx.TriggerPxType
+	}
+	// TakeProfitLevel is one of the take profit levels of NewBracketOrder, closing Sz of the position
+	TakeProfitLevel struct {
+		Leg
+		Sz float64
+	}
+)
+
+// NewConditionalOrder returns a one-way stop order with a take profit, a stop loss or both
+func NewConditionalOrder(base AlgoOrderBase, tp, sl *Leg) (PlaceAlgoOrder, error) {
+	if tp == nil && sl == nil {
+		return PlaceAlgoOrder{}, errors.New("okx: conditional order without take profit nor stop loss")
+	}
+	req := base.algo(okx.AlgoOrderConditional)
+	so, err := stopOrder(tp, sl)
+	req.StopOrder = so
+
+	return req, err
+}
+
+// NewOCOOrder returns a one-cancels-the-other order, placing the take profit or the stop loss whichever triggers first
+func NewOCOOrder(base AlgoOrderBase, tp, sl Leg) (PlaceAlgoOrder, error) {
+	req := base.algo(okx.AlgoOrderOCO)
+	so, err := stopOrder(&tp, &sl)
+	req.StopOrder = so
+
+	return req, err
+}
+
+// NewTriggerOrder returns an order placed at the price of trigger once it triggers
+func NewTriggerOrder(base AlgoOrderBase, trigger Leg) (PlaceAlgoOrder, error) {
+	if err := trigger.validate("trigger"); err != nil {
+		return PlaceAlgoOrder{}, err
+	}
+	req := base.algo(okx.AlgoOrderTrigger)
+	req.TriggerOrder = TriggerOrder{
+		TriggerPx:     trigger.TriggerPx,
+		TriggerPxType: string(trigger.TriggerPxType),
+		OrdPx:         trigger.OrdPx,
+	}
+
+	return req, nil
+}
+
+// NewIcebergOrder returns an order split into orders of at most szLimit, placed at most pxLimit away from the best price.
+//
+// The distance to the best bid or offer is either the ratio pxVar or the spread pxSpread, exactly one of them must be set.
+func NewIcebergOrder(base AlgoOrderBase, pxVar, pxSpread, szLimit, pxLimit float64) (PlaceAlgoOrder, error) {
+	io, err := icebergOrder(pxVar, pxSpread, szLimit, pxLimit)
+	if err != nil {
+		return PlaceAlgoOrder{}, err
+	}
+	req := base.algo(okx.AlgoOrderIceberg)
+	req.IcebergOrder = io
+
+	return req, nil
+}
+
+// NewTWAPOrder returns an order split like NewIcebergOrder, whose orders are placed every timeInterval seconds
+func NewTWAPOrder(base AlgoOrderBase, pxVar, pxSpread, szLimit, pxLimit float64, timeInterval int) (PlaceAlgoOrder, error) {
+	io, err := icebergOrder(pxVar, pxSpread, szLimit, pxLimit)
+	if err != nil {
+		return PlaceAlgoOrder{}, err
+	}
+	if timeInterval <= 0 {
+		return PlaceAlgoOrder{}, fmt.Errorf("okx: twap order time interval %d isn't positive", timeInterval)
+	}
+	req := base.algo(okx.AlgoOrderTwap)
+	req.TWAPOrder = TWAPOrder{IcebergOrder: io, TimeInterval: fmt.Sprint(timeInterval)}
+
+	return req, nil
+}
+
+// NewTrailingStopOrder returns a market order placed once the price retraces from its extreme by callbackRatio or callbackSpread,
+// exactly one of them must be set. The tracking starts once activePx is reached, or right away when it's zero.
+func NewTrailingStopOrder(base AlgoOrderBase, callbackRatio, callbackSpread, activePx float64) (PlaceAlgoOrder, error) {
+	if (callbackRatio > 0) == (callbackSpread > 0) {
+		return PlaceAlgoOrder{}, errors.New("okx: trailing stop order needs either a callback ratio or a callback spread")
+	}
+	if callbackRatio >= 1 {
+		return PlaceAlgoOrder{}, fmt.Errorf("okx: trailing stop order callback ratio %v isn't below 1", callbackRatio)
+	}
+	req := base.algo(okx.AlgoOrderTrailing)
+	req.TrailingStopOrder = TrailingStopOrder{CallbackRatio: callbackRatio, CallbackSpread: callbackSpread, ActivePx: activePx}
+
+	return req, nil
+}
+
+// NewAttachedTPSL returns a take profit, a stop loss or both to attach to an order, covering the whole order
+func NewAttachedTPSL(tp, sl *Leg) (*AttachAlgoOrd, error) {
+	if tp == nil && sl == nil {
+		return nil, errors.New("okx: attached order without take profit nor stop loss")
+	}
+	so, err := stopOrder(tp, sl)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AttachAlgoOrd{
+		TpTriggerPx:     so.TpTriggerPx,
+		TpOrdPx:         so.TpOrdPx,
+		TpTriggerPxType: so.TpTriggerPxType,
+		SlTriggerPx:     so.SlTriggerPx,
+		SlOrdPx:         so.SlOrdPx,
+		SlTriggerPxType: so.SlTriggerPxType,
+	}, nil
+}
+
+// NewBracketOrder returns order with take profit levels and a stop loss attached, replacing its AttachAlgoOrds.
+//
+// Each level closes its Sz, which must add up to the Sz of the order when there are several of them,
+// and carries the stop loss for its Sz so that the whole position is protected until it's closed.
+// A single level covers the whole order, its Sz is left zero or set to the Sz of the order.
+//
+// The triggers must be on the side of the order: for a buy the take profits above the stop loss and the order price,
+// the stop loss below them, the other way around for a sell.
+func NewBracketOrder(order PlaceOrder, tps []TakeProfitLevel, sl *Leg) (PlaceOrder, error) {
+	for i := range tps {
+		if err := bracketSides(order, &tps[i].Leg, sl); err != nil {
+			return order, err
+		}
+	}
+	if sl != nil {
+		if err := bracketSides(order, nil, sl); err != nil {
+			return order, err
+		}
+	}
+	if len(tps) == 1 && tps[0].Sz != 0 && !approxEqual(tps[0].Sz, order.Sz) {
+		return order, fmt.Errorf("okx: single take profit level size %v doesn't match the order size %v", tps[0].Sz, order.Sz)
+	}
+	if len(tps) == 0 {
+		a, err := NewAttachedTPSL(nil, sl)
+		if err != nil {
+			return order, err
+		}
+		order.AttachAlgoOrds = []*AttachAlgoOrd{a}
+		return order, nil
+	}
+
+	var total float64
+	attached := make([]*AttachAlgoOrd, len(tps))
+	for i := range tps {
+		a, err := NewAttachedTPSL(&tps[i].Leg, sl)
+		if err != nil {
+			return order, err
+		}
+		if len(tps) > 1 {
+			if tps[i].Sz <= 0 {
+				return order, fmt.Errorf("okx: take profit level %d has no size", i)
+			}
+			a.Sz = tps[i].Sz
+			total += tps[i].Sz
+		}
+		attached[i] = a
+	}
+	if len(tps) > 1 && !approxEqual(total, order.Sz) {
+		return order, fmt.Errorf("okx: take profit levels size %v doesn't match the order size %v", total, order.Sz)
+	}
+	order.AttachAlgoOrds = attached
+
+	return order, nil
+}
+
+// bracketSides returns an error when the take profit tp or the stop loss sl triggers on the wrong side of order
+func bracketSides(order PlaceOrder, tp, sl *Leg) error {
+	// legs without a trigger price are reported by validate
+	if tp != nil && tp.TriggerPx <= 0 {
+		tp = nil
+	}
+	if sl != nil && sl.TriggerPx <= 0 {
+		sl = nil
+	}
+	sign := 1.0
+	if order.Side == okx.OrderSell {
+		sign = -1
+	}
+	above := func(a, b float64) bool { return sign*(a-b) > 0 }
+	switch {
+	case tp != nil && order.Px > 0 && !above(tp.TriggerPx, order.Px):
+		return fmt.Errorf("okx: take profit trigger %v on the wrong side of the %s order price %v", tp.TriggerPx, order.Side, order.Px)
+	case sl != nil && order.Px > 0 && !above(order.Px, sl.TriggerPx):
+		return fmt.Errorf("okx: stop loss trigger %v on the wrong side of the %s order price %v", sl.TriggerPx, order.Side, order.Px)
+	case tp != nil && sl != nil && !above(tp.TriggerPx, sl.TriggerPx):
+		return fmt.Errorf("okx: take profit trigger %v on the wrong side of the stop loss trigger %v for a %s order", tp.TriggerPx, sl.TriggerPx, order.Side)
+	}
+
+	return nil
+}
+
+func (b AlgoOrderBase) algo(t okx.AlgoOrderType) PlaceAlgoOrder {
+	return PlaceAlgoOrder{
+		InstID:     b.InstID,
+		TdMode:     b.TdMode,
+		Ccy:        b.Ccy,
+		ClOrdID:    b.ClOrdID,
+		Tag:        b.Tag,
+		Side:       b.Side,
+		PosSide:    b.PosSide,
+		OrdType:    t,
+		Sz:         b.Sz,
+		ReduceOnly: b.ReduceOnly,
+		TgtCcy:     b.TgtCcy,
+	}
+}
+
+func (l Leg) validate(name string) error {
+	if !l.TriggerPxType.Valid() {
+		return fmt.Errorf("okx: %s trigger price type %q isn't one of last, index or mark", name, l.TriggerPxType)
+	}
+	if l.TriggerPx <= 0 {
+		return fmt.Errorf("okx: %s trigger price %v isn't positive", name, l.TriggerPx)
+	}
+	if l.OrdPx <= 0 && l.OrdPx != MarketPx {
+		return fmt.Errorf("okx: %s order price %v is neither positive nor MarketPx", name, l.OrdPx)
+	}
+
+	return nil
+}
+
+func stopOrder(tp, sl *Leg) (so StopOrder, err error) {
+	if tp != nil {
+		if err = tp.validate("take profit"); err != nil {
+			return
+		}
+		so.TpTriggerPx, so.TpOrdPx, so.TpTriggerPxType = tp.TriggerPx, tp.OrdPx, string(tp.TriggerPxType)
+	}
+	if sl != nil {
+		if err = sl.validate("stop loss"); err != nil {
+			return
+		}
+		so.SlTriggerPx, so.SlOrdPx, so.SlTriggerPxType = sl.TriggerPx, sl.OrdPx, string(sl.TriggerPxType)
+	}
+
+	return
+}
+
+func icebergOrder(pxVar, pxSpread, szLimit, pxLimit float64) (IcebergOrder, error) {
+	if (pxVar > 0) == (pxSpread > 0) {
+		return IcebergOrder{}, errors.New("okx: iceberg order needs either a price variance or a price spread")
+	}
+	if szLimit <= 0 || pxLimit <= 0 {
+		return IcebergOrder{}, fmt.Errorf("okx: iceberg order size limit %v and price limit %v must be positive", szLimit, pxLimit)
+	}
+
+	return IcebergOrder{PxVar: pxVar, PxSpread: pxSpread, SzLimit: szLimit, PxLimit: pxLimit}, nil
+}
+
+func approxEqual(a, b float64) bool {
+	d := a - b
+	if d < 0 {
+		d = -d
+	}
+
+	return d <= 1e-9*(1+b)
+}
@@ -0,0 +1,75 @@
+package trade
+
+import (
+	"testing"
+
+	"github.com/liuhengloveyou/okx-go"
+)
+
+func TestNewBracketOrder(t *testing.T) {
+	leg := func(px float64) Leg {
+		return Leg{TriggerPx: px, OrdPx: MarketPx, TriggerPxType: okx.TriggerPxLast}
+	}
+	level := func(px, sz float64) TakeProfitLevel {
+		return TakeProfitLevel{Leg: leg(px), Sz: sz}
+	}
+	sl := func(px float64) *Leg {
+		l := leg(px)
+		return &l
+	}
+	buy := PlaceOrder{InstID: "BTC-USDT", Side: okx.OrderBuy, OrdType: okx.OrderLimit, Px: 100, Sz: 2}
+	sell := buy
+	sell.Side = okx.OrderSell
+	market := buy
+	market.OrdType, market.Px = okx.OrderMarket, 0
+
+	tests := []struct {
+		name    string
+		order   PlaceOrder
+		tps     []TakeProfitLevel
+		sl      *Leg
+		wantSz  []float64 // Sz of the attached orders
+		wantErr bool
+	}{
+		{name: "buy", order: buy, tps: []TakeProfitLevel{level(110, 0)}, sl: sl(90), wantSz: []float64{0}},
+		{name: "sell", order: sell, tps: []TakeProfitLevel{level(90, 0)}, sl: sl(110), wantSz: []float64{0}},
+		{name: "stop loss only", order: buy, sl: sl(90), wantSz: []float64{0}},
+		{name: "market order", order: market, tps: []TakeProfitLevel{level(110, 0)}, sl: sl(90), wantSz: []float64{0}},
+		{name: "single level of the order size", order: buy, tps: []TakeProfitLevel{level(110, 2)}, sl: sl(90), wantSz: []float64{0}},
+		{name: "levels", order: buy, tps: []TakeProfitLevel{level(110, 1.5), level(120, 0.5)}, sl: sl(90), wantSz: []float64{1.5, 0.5}},
+		{name: "partial single level", order: buy, tps: []TakeProfitLevel{level(110, 1)}, sl: sl(90), wantErr: true},
+		{name: "levels not adding up", order: buy, tps: []TakeProfitLevel{level(110, 1), level(120, 0.5)}, wantErr: true},
+		{name: "level without size", order: buy, tps: []TakeProfitLevel{level(110, 2), level(120, 0)}, wantErr: true},
+		{name: "buy take profit below the price", order: buy, tps: []TakeProfitLevel{level(95, 0)}, wantErr: true},
+		{name: "buy stop loss above the price", order: buy, sl: sl(105), wantErr: true},
+		{name: "sell take profit above the price", order: sell, tps: []TakeProfitLevel{level(105, 0)}, wantErr: true},
+		{name: "sell stop loss below the price", order: sell, sl: sl(95), wantErr: true},
+		{name: "market take profit below the stop loss", order: market, tps: []TakeProfitLevel{level(90, 0)}, sl: sl(110), wantErr: true},
+		{name: "no trigger price", order: buy, sl: sl(0), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewBracketOrder(tt.order, tt.tps, tt.sl)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewBracketOrder() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if len(got.AttachAlgoOrds) != len(tt.wantSz) {
+				t.Fatalf("got %d attached orders, want %d", len(got.AttachAlgoOrds), len(tt.wantSz))
+			}
+			for i, a := range got.AttachAlgoOrds {
+				if a.Sz != tt.wantSz[i] {
+					t.Errorf("attached order %d Sz = %v, want %v", i, a.Sz, tt.wantSz[i])
+				}
+				if tt.sl != nil && a.SlTriggerPx != tt.sl.TriggerPx {
+					t.Errorf("attached order %d SlTriggerPx = %v, want %v", i, a.SlTriggerPx, tt.sl.TriggerPx)
+				}
+				if len(tt.tps) > 0 && a.TpTriggerPx != tt.tps[i].TriggerPx {
+					t.Errorf("attached order %d TpTriggerPx = %v, want %v", i, a.TpTriggerPx, tt.tps[i].TriggerPx)
+				}
+			}
+		})
+	}
+}
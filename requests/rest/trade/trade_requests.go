@@ -1,6 +1,8 @@
 package trade
 
 import (
+	"time"
+
 	"github.com/liuhengloveyou/okx-go"
 	"github.com/liuhengloveyou/okx-go/models/trade"
 )
@@ -27,6 +29,20 @@ type (
 		SlTriggerPx     float64          `json:"slTriggerPx,string,omitempty"`
 		SlOrdPx         float64          `json:"slOrdPx,string,omitempty"`
 		SlTriggerPxType string           `json:"slTriggerPxType,omitempty"`
+		AttachAlgoOrds  []*AttachAlgoOrd `json:"attachAlgoOrds,omitempty"`
+		ExpTime         time.Time        `json:"-"`
+	}
+	AttachAlgoOrd struct {
+		AttachAlgoClOrdID    string  `json:"attachAlgoClOrdId,omitempty"`
+		Sz                   float64 `json:"sz,omitempty,string"`
+		TpTriggerPx          float64 `json:"tpTriggerPx,omitempty,string"`
+		TpOrdPx              float64 `json:"tpOrdPx,omitempty,string"`
+		TpOrdKind            string  `json:"tpOrdKind,omitempty"`
+		TpTriggerPxType      string  `json:"tpTriggerPxType,omitempty"`
+		SlTriggerPx          float64 `json:"slTriggerPx,omitempty,string"`
+		SlOrdPx              float64 `json:"slOrdPx,omitempty,string"`
+		SlTriggerPxType      string  `json:"slTriggerPxType,omitempty"`
+		AmendPxOnTriggerType string  `json:"amendPxOnTriggerType,omitempty"`
 	}
 	CancelOrder struct {
 		ID      string `json:"-"`
@@ -35,14 +51,15 @@ type (
 		ClOrdID string `json:"clOrdId,omitempty"`
 	}
 	AmendOrder struct {
-		ID        string  `json:"-"`
-		InstID    string  `json:"instId"`
-		OrdID     string  `json:"ordId,omitempty"`
-		ClOrdID   string  `json:"clOrdId,omitempty"`
-		ReqID     string  `json:"reqId,omitempty"`
-		NewSz     float64 `json:"newSz,omitempty,string"`
-		NewPx     float64 `json:"newPx,omitempty,string"`
-		CxlOnFail bool    `json:"cxlOnFail,omitempty"`
+		ID        string    `json:"-"`
+		InstID    string    `json:"instId"`
+		OrdID     string    `json:"ordId,omitempty"`
+		ClOrdID   string    `json:"clOrdId,omitempty"`
+		ReqID     string    `json:"reqId,omitempty"`
+		NewSz     float64   `json:"newSz,omitempty,string"`
+		NewPx     float64   `json:"newPx,omitempty,string"`
+		CxlOnFail bool      `json:"cxlOnFail,omitempty"`
+		ExpTime   time.Time `json:"-"`
 	}
 	ClosePosition struct {
 		InstID  string           `json:"instId"`
@@ -71,6 +88,8 @@ type (
 		OrdID    string             `json:"ordId,omitempty"`
 		After    float64            `json:"after,omitempty,string"`
 		Before   float64            `json:"before,omitempty,string"`
+		Begin    int64              `json:"begin,omitempty,string"`
+		End      int64              `json:"end,omitempty,string"`
 		Limit    float64            `json:"limit,omitempty,string"`
 		InstType okx.InstrumentType `json:"instType,omitempty"`
 	}
@@ -126,6 +145,54 @@ type (
 		InstID string `json:"instId"`
 		AlgoID string `json:"algoId"`
 	}
+	AmendAlgoOrder struct {
+		ID                 string  `json:"-"`
+		InstID             string  `json:"instId"`
+		AlgoID             string  `json:"algoId,omitempty"`
+		AlgoClOrdID        string  `json:"algoClOrdId,omitempty"`
+		ReqID              string  `json:"reqId,omitempty"`
+		CxlOnFail          bool    `json:"cxlOnFail,omitempty"`
+		NewSz              float64 `json:"newSz,omitempty,string"`
+		NewTpTriggerPx     float64 `json:"newTpTriggerPx,omitempty,string"`
+		NewTpOrdPx         float64 `json:"newTpOrdPx,omitempty,string"`
+		NewTpTriggerPxType string  `json:"newTpTriggerPxType,omitempty"`
+		NewSlTriggerPx     float64 `json:"newSlTriggerPx,omitempty,string"`
+		NewSlOrdPx         float64 `json:"newSlOrdPx,omitempty,string"`
+		NewSlTriggerPxType string  `json:"newSlTriggerPxType,omitempty"`
+		NewTriggerPx       float64 `json:"newTriggerPx,omitempty,string"`
+		NewOrdPx           float64 `json:"newOrdPx,omitempty,string"`
+		NewTriggerPxType   string  `json:"newTriggerPxType,omitempty"`
+	}
+	AlgoOrderDetails struct {
+		AlgoID      string `json:"algoId,omitempty"`
+		AlgoClOrdID string `json:"algoClOrdId,omitempty"`
+	}
+	MassCancel struct {
+		ID           string             `json:"-"`
+		InstType     okx.InstrumentType `json:"instType"`
+		InstFamily   string             `json:"instFamily"`
+		LockInterval int64              `json:"lockInterval,omitempty,string"`
+	}
+	CancelAllAfter struct {
+		TimeOut int64  `json:"timeOut,string"`
+		Tag     string `json:"tag,omitempty"`
+	}
+	OneClickRepayCurrencyList struct {
+		DebtType string `json:"debtType,omitempty"`
+	}
+	OneClickRepay struct {
+		DebtCcy  []string `json:"debtCcy"`
+		RepayCcy string   `json:"repayCcy"`
+	}
+	OneClickRepayHistory struct {
+		After  int64 `json:"after,omitempty,string"`
+		Before int64 `json:"before,omitempty,string"`
+		Limit  int64 `json:"limit,omitempty,string"`
+	}
+	FillsArchive struct {
+		Year    string `json:"year"`
+		Quarter string `json:"quarter"`
+	}
 	AlgoOrderList struct {
 		InstType okx.InstrumentType `json:"instType,omitempty"`
 		Uly      string             `json:"uly,omitempty"`
@@ -0,0 +1,149 @@
+package tradingbot
+
+import "github.com/liuhengloveyou/okx-go"
+
+type (
+	PlaceGridOrder struct {
+		InstID             string            `json:"instId"`
+		AlgoOrdType        okx.AlgoOrderType `json:"algoOrdType"`
+		MaxPx              float64           `json:"maxPx,string"`
+		MinPx              float64           `json:"minPx,string"`
+		GridNum            int64             `json:"gridNum,string"`
+		RunType            okx.GridRunType   `json:"runType,omitempty"`
+		TpTriggerPx        float64           `json:"tpTriggerPx,omitempty,string"`
+		SlTriggerPx        float64           `json:"slTriggerPx,omitempty,string"`
+		AlgoClOrdID        string            `json:"algoClOrdId,omitempty"`
+		Tag                string            `json:"tag,omitempty"`
+		ProfitSharingRatio float64           `json:"profitSharingRatio,omitempty,string"`
+		TriggerParams      []*TriggerParam   `json:"triggerParams,omitempty"`
+		SpotGrid
+		ContractGrid
+	}
+	SpotGrid struct {
+		QuoteSz float64 `json:"quoteSz,omitempty,string"`
+		BaseSz  float64 `json:"baseSz,omitempty,string"`
+	}
+	ContractGrid struct {
+		Sz        float64           `json:"sz,omitempty,string"`
+		Direction okx.GridDirection `json:"direction,omitempty"`
+		Lever     float64           `json:"lever,omitempty,string"`
+		BasePos   bool              `json:"basePos,omitempty"`
+		TpRatio   float64           `json:"tpRatio,omitempty,string"`
+		SlRatio   float64           `json:"slRatio,omitempty,string"`
+	}
+	TriggerParam struct {
+		TriggerAction   string  `json:"triggerAction"`
+		TriggerStrategy string  `json:"triggerStrategy"`
+		DelaySeconds    int64   `json:"delaySeconds,omitempty,string"`
+		Timeframe       string  `json:"timeframe,omitempty"`
+		Thold           float64 `json:"thold,omitempty,string"`
+		TriggerCond     string  `json:"triggerCond,omitempty"`
+		TimePeriod      int64   `json:"timePeriod,omitempty,string"`
+		TriggerPx       float64 `json:"triggerPx,omitempty,string"`
+		StopType        string  `json:"stopType,omitempty"`
+	}
+	AmendGridOrder struct {
+		AlgoID        string          `json:"algoId"`
+		InstID        string          `json:"instId"`
+		SlTriggerPx   float64         `json:"slTriggerPx,omitempty,string"`
+		TpTriggerPx   float64         `json:"tpTriggerPx,omitempty,string"`
+		TpRatio       float64         `json:"tpRatio,omitempty,string"`
+		SlRatio       float64         `json:"slRatio,omitempty,string"`
+		TriggerParams []*TriggerParam `json:"triggerParams,omitempty"`
+	}
+	StopGridOrder struct {
+		AlgoID      string            `json:"algoId"`
+		InstID      string            `json:"instId"`
+		AlgoOrdType okx.AlgoOrderType `json:"algoOrdType"`
+		StopType    string            `json:"stopType"`
+	}
+	GridOrderList struct {
+		AlgoOrdType okx.AlgoOrderType  `json:"algoOrdType"`
+		AlgoID      string             `json:"algoId,omitempty"`
+		InstID      string             `json:"instId,omitempty"`
+		InstType    okx.InstrumentType `json:"instType,omitempty"`
+		After       string             `json:"after,omitempty"`
+		Before      string             `json:"before,omitempty"`
+		Limit       int64              `json:"limit,omitempty,string"`
+	}
+	GridOrderDetails struct {
+		AlgoOrdType okx.AlgoOrderType `json:"algoOrdType"`
+		AlgoID      string            `json:"algoId"`
+	}
+	GridSubOrders struct {
+		AlgoOrdType okx.AlgoOrderType `json:"algoOrdType"`
+		AlgoID      string            `json:"algoId"`
+		Type        string            `json:"type"`
+		GroupID     string            `json:"groupId,omitempty"`
+		After       string            `json:"after,omitempty"`
+		Before      string            `json:"before,omitempty"`
+		Limit       int64             `json:"limit,omitempty,string"`
+	}
+	GridPositions struct {
+		AlgoOrdType okx.AlgoOrderType `json:"algoOrdType"`
+		AlgoID      string            `json:"algoId"`
+	}
+	GridAIParam struct {
+		AlgoOrdType okx.AlgoOrderType `json:"algoOrdType"`
+		InstID      string            `json:"instId"`
+		Direction   okx.GridDirection `json:"direction,omitempty"`
+		Duration    string            `json:"duration,omitempty"`
+	}
+	MinInvestment struct {
+		InstID         string            `json:"instId"`
+		AlgoOrdType    okx.AlgoOrderType `json:"algoOrdType"`
+		MaxPx          float64           `json:"maxPx,string"`
+		MinPx          float64           `json:"minPx,string"`
+		GridNum        int64             `json:"gridNum,string"`
+		RunType        okx.GridRunType   `json:"runType"`
+		Direction      okx.GridDirection `json:"direction,omitempty"`
+		Lever          float64           `json:"lever,omitempty,string"`
+		BasePos        bool              `json:"basePos,omitempty"`
+		InvestmentData []*InvestmentData `json:"investmentData,omitempty"`
+	}
+	InvestmentData struct {
+		Amt float64 `json:"amt,string"`
+		Ccy string  `json:"ccy"`
+	}
+	PlaceRecurringOrder struct {
+		StgyName      string               `json:"stgyName"`
+		RecurringList []*RecurringCurrency `json:"recurringList"`
+		Period        okx.RecurringPeriod  `json:"period"`
+		RecurringDay  string               `json:"recurringDay,omitempty"`
+		RecurringHour string               `json:"recurringHour,omitempty"`
+		RecurringTime string               `json:"recurringTime"`
+		TimeZone      string               `json:"timeZone"`
+		Amt           float64              `json:"amt,string"`
+		InvestmentCcy string               `json:"investmentCcy"`
+		TdMode        okx.TradeMode        `json:"tdMode"`
+		AlgoClOrdID   string               `json:"algoClOrdId,omitempty"`
+		Tag           string               `json:"tag,omitempty"`
+	}
+	RecurringCurrency struct {
+		Ccy   string  `json:"ccy"`
+		Ratio float64 `json:"ratio,string"`
+	}
+	AmendRecurringOrder struct {
+		AlgoID   string `json:"algoId"`
+		StgyName string `json:"stgyName"`
+	}
+	StopRecurringOrder struct {
+		AlgoID string `json:"algoId"`
+	}
+	RecurringOrderList struct {
+		AlgoID string `json:"algoId,omitempty"`
+		After  string `json:"after,omitempty"`
+		Before string `json:"before,omitempty"`
+		Limit  int64  `json:"limit,omitempty,string"`
+	}
+	RecurringOrderDetails struct {
+		AlgoID string `json:"algoId"`
+	}
+	RecurringSubOrders struct {
+		AlgoID string `json:"algoId"`
+		OrdID  string `json:"ordId,omitempty"`
+		After  string `json:"after,omitempty"`
+		Before string `json:"before,omitempty"`
+		Limit  int64  `json:"limit,omitempty,string"`
+	}
+)
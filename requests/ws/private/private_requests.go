@@ -25,4 +25,24 @@ type (
 		InstID   string             `json:"instId,omitempty"`
 		InstType okx.InstrumentType `json:"instType"`
 	}
+	SpreadOrders struct {
+		SprdID string `json:"sprdId,omitempty"`
+	}
+	SpreadTrades struct {
+		SprdID string `json:"sprdId,omitempty"`
+	}
+	GridOrders struct {
+		InstType okx.InstrumentType `json:"instType"`
+		InstID   string             `json:"instId,omitempty"`
+		AlgoID   string             `json:"algoId,omitempty"`
+	}
+	GridPositions struct {
+		AlgoID string `json:"algoId"`
+	}
+	GridSubOrders struct {
+		AlgoID string `json:"algoId"`
+	}
+	CopyTradingNotification struct {
+		InstType okx.InstrumentType `json:"instType"`
+	}
 )
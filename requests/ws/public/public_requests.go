@@ -52,4 +52,13 @@ type (
 	IndexTickers struct {
 		InstID string `json:"instId"`
 	}
+	SpreadOrderBook struct {
+		SprdID string `json:"sprdId"`
+	}
+	SpreadPublicTrades struct {
+		SprdID string `json:"sprdId"`
+	}
+	SpreadTickers struct {
+		SprdID string `json:"sprdId"`
+	}
 )
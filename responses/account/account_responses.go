@@ -82,4 +82,64 @@ type (
 		responses.Basic
 		AccountLevels []*models.AcctLevel `json:"data"`
 	}
+	SetIsolatedMode struct {
+		responses.Basic
+		IsolatedModes []*models.IsolatedMode `json:"data"`
+	}
+	PrecheckAccountLevel struct {
+		responses.Basic
+		Prechecks []*models.AccountLevelPrecheck `json:"data"`
+	}
+	SetRiskOffsetType struct {
+		responses.Basic
+		RiskOffsetTypes []*models.RiskOffsetType `json:"data"`
+	}
+	SetAutoRepay struct {
+		responses.Basic
+		AutoRepays []*models.AutoRepay `json:"data"`
+	}
+	ActivateOption struct {
+		responses.Basic
+		Activations []*models.OptionActivation `json:"data"`
+	}
+	MMPConfig struct {
+		responses.Basic
+		Configs []*models.MMPConfig `json:"data"`
+	}
+	ResetMMP struct {
+		responses.Basic
+		Results []*models.MMPReset `json:"data"`
+	}
+	QuickMarginBorrowRepay struct {
+		responses.Basic
+		BorrowRepays []*models.QuickMarginBorrowRepay `json:"data"`
+	}
+	GetQuickMarginBorrowRepayHistory struct {
+		responses.Basic
+		Records []*models.QuickMarginBorrowRepayRecord `json:"data"`
+	}
+	PositionBuilder struct {
+		responses.Basic
+		PositionBuilders []*models.PositionBuilder `json:"data"`
+	}
+	GetGreeks struct {
+		responses.Basic
+		Greeks []*models.Greeks `json:"data"`
+	}
+	GetPositionsHistory struct {
+		responses.Basic
+		Positions []*models.PositionHistory `json:"data"`
+	}
+	RequestBillsArchive struct {
+		responses.Basic
+		Results []*models.BillsArchiveRequest `json:"data"`
+	}
+	GetBillsArchive struct {
+		responses.Basic
+		Archives []*models.BillsArchive `json:"data"`
+	}
+	FixedLoanBorrowingOrder struct {
+		responses.Basic
+		Orders []*models.FixedLoanBorrowingOrder `json:"data"`
+	}
 )
@@ -0,0 +1,49 @@
+package block_trading
+
+import (
+	"github.com/liuhengloveyou/okx-go/models/blocktrading"
+	"github.com/liuhengloveyou/okx-go/responses"
+)
+
+type (
+	Counterparties struct {
+		responses.Basic
+		Counterparties []*blocktrading.Counterparty `json:"data"`
+	}
+	RFQs struct {
+		responses.Basic
+		RFQs []*blocktrading.RFQ `json:"data"`
+	}
+	CancelRFQ struct {
+		responses.Basic
+		CancelRFQs []*blocktrading.CancelRFQ `json:"data"`
+	}
+	Quotes struct {
+		responses.Basic
+		Quotes []*blocktrading.Quote `json:"data"`
+	}
+	CancelQuote struct {
+		responses.Basic
+		CancelQuotes []*blocktrading.CancelQuote `json:"data"`
+	}
+	CancelAll struct {
+		responses.Basic
+		CancelAlls []*blocktrading.CancelAll `json:"data"`
+	}
+	Trades struct {
+		responses.Basic
+		Trades []*blocktrading.BlockTrade `json:"data"`
+	}
+	PublicTrades struct {
+		responses.Basic
+		Trades []*blocktrading.PublicBlockTrade `json:"data"`
+	}
+	MMPConfig struct {
+		responses.Basic
+		MMPConfigs []*blocktrading.MMPConfig `json:"data"`
+	}
+	MMPReset struct {
+		responses.Basic
+		MMPResets []*blocktrading.MMPReset `json:"data"`
+	}
+)
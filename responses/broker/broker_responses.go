@@ -0,0 +1,52 @@
+package broker
+
+import (
+	"github.com/liuhengloveyou/okx-go/models/broker"
+	"github.com/liuhengloveyou/okx-go/responses"
+)
+
+type (
+	SubAccount struct {
+		responses.Basic
+		SubAccounts []*broker.SubAccount `json:"data"`
+	}
+	SubAccountList struct {
+		responses.Basic
+		SubAccountLists []*broker.SubAccountList `json:"data"`
+	}
+	Empty struct {
+		responses.Basic
+	}
+	APIKey struct {
+		responses.Basic
+		APIKeys []*broker.APIKey `json:"data"`
+	}
+	SubAccountLevel struct {
+		responses.Basic
+		Levels []*broker.SubAccountLevel `json:"data"`
+	}
+	FeeRate struct {
+		responses.Basic
+		FeeRates []*broker.FeeRate `json:"data"`
+	}
+	DepositAddress struct {
+		responses.Basic
+		Addresses []*broker.DepositAddress `json:"data"`
+	}
+	RebateDaily struct {
+		responses.Basic
+		Rebates []*broker.RebateDaily `json:"data"`
+	}
+	RebateReport struct {
+		responses.Basic
+		Reports []*broker.RebateReport `json:"data"`
+	}
+	GenerateRebateReport struct {
+		responses.Basic
+		Results []*broker.RebateReportRequest `json:"data"`
+	}
+	InviteeDetail struct {
+		responses.Basic
+		Details []*broker.InviteeDetail `json:"data"`
+	}
+)
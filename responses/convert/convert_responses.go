@@ -0,0 +1,25 @@
+package convert
+
+import (
+	"github.com/liuhengloveyou/okx-go/models/convert"
+	"github.com/liuhengloveyou/okx-go/responses"
+)
+
+type (
+	Currencies struct {
+		responses.Basic
+		Currencies []*convert.Currency `json:"data"`
+	}
+	CurrencyPair struct {
+		responses.Basic
+		Pairs []*convert.CurrencyPair `json:"data"`
+	}
+	EstimateQuote struct {
+		responses.Basic
+		Quotes []*convert.Quote `json:"data"`
+	}
+	Trade struct {
+		responses.Basic
+		Trades []*convert.Trade `json:"data"`
+	}
+)
@@ -0,0 +1,45 @@
+package copy_trading
+
+import (
+	"github.com/liuhengloveyou/okx-go/models/copytrading"
+	"github.com/liuhengloveyou/okx-go/responses"
+)
+
+type (
+	SubPositions struct {
+		responses.Basic
+		SubPositions []*copytrading.SubPosition `json:"data"`
+	}
+	SubPositionResult struct {
+		responses.Basic
+		Results []*copytrading.SubPositionResult `json:"data"`
+	}
+	LeadInstruments struct {
+		responses.Basic
+		Instruments []*copytrading.LeadInstrument `json:"data"`
+	}
+	ProfitSharingDetails struct {
+		responses.Basic
+		Details []*copytrading.ProfitSharingDetail `json:"data"`
+	}
+	TotalProfitSharing struct {
+		responses.Basic
+		Totals []*copytrading.TotalProfitSharing `json:"data"`
+	}
+	UnrealizedProfitSharing struct {
+		responses.Basic
+		Details []*copytrading.UnrealizedProfitSharing `json:"data"`
+	}
+	CopyTraders struct {
+		responses.Basic
+		CopyTraders []*copytrading.CopyTraders `json:"data"`
+	}
+	LeadTraders struct {
+		responses.Basic
+		LeadTraders []*copytrading.LeadTraders `json:"data"`
+	}
+	LeadTraderStats struct {
+		responses.Basic
+		Stats []*copytrading.LeadTraderStats `json:"data"`
+	}
+)
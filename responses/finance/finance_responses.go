@@ -0,0 +1,60 @@
+package finance
+
+import (
+	"github.com/liuhengloveyou/okx-go/models/finance"
+	"github.com/liuhengloveyou/okx-go/responses"
+)
+
+type (
+	SavingsBalance struct {
+		responses.Basic
+		Balances []*finance.SavingsBalance `json:"data"`
+	}
+	SavingsPurchaseRedemption struct {
+		responses.Basic
+		Results []*finance.SavingsPurchaseRedemption `json:"data"`
+	}
+	LendingRate struct {
+		responses.Basic
+		Rates []*finance.LendingRate `json:"data"`
+	}
+	LendingHistory struct {
+		responses.Basic
+		Histories []*finance.LendingHistory `json:"data"`
+	}
+	LendingRateSummary struct {
+		responses.Basic
+		Summaries []*finance.LendingRateSummary `json:"data"`
+	}
+	PublicLendingRate struct {
+		responses.Basic
+		Rates []*finance.PublicLendingRate `json:"data"`
+	}
+	StakingOffers struct {
+		responses.Basic
+		Offers []*finance.StakingOffer `json:"data"`
+	}
+	StakingOrderResult struct {
+		responses.Basic
+		Results []*finance.StakingOrderResult `json:"data"`
+	}
+	StakingOrders struct {
+		responses.Basic
+		Orders []*finance.StakingOrder `json:"data"`
+	}
+	CoinStaking struct {
+		responses.Basic
+	}
+	CoinStakingBalance struct {
+		responses.Basic
+		Balances []*finance.CoinStakingBalance `json:"data"`
+	}
+	CoinStakingHistory struct {
+		responses.Basic
+		Histories []*finance.CoinStakingHistory `json:"data"`
+	}
+	CoinStakingApy struct {
+		responses.Basic
+		Rates []*finance.CoinStakingApy `json:"data"`
+	}
+)
@@ -0,0 +1,52 @@
+package loan
+
+import (
+	"github.com/liuhengloveyou/okx-go/models/loan"
+	"github.com/liuhengloveyou/okx-go/responses"
+)
+
+type (
+	BorrowCurrencies struct {
+		responses.Basic
+		Currencies []*loan.BorrowCurrency `json:"data"`
+	}
+	CollateralAssets struct {
+		responses.Basic
+		CollateralAssets []*loan.CollateralAssets `json:"data"`
+	}
+	MaxLoan struct {
+		responses.Basic
+		MaxLoans []*loan.MaxLoan `json:"data"`
+	}
+	MaxCollateralRedeem struct {
+		responses.Basic
+		MaxRedeems []*loan.MaxCollateralRedeem `json:"data"`
+	}
+	AdjustCollateral struct {
+		responses.Basic
+	}
+	LoanInfo struct {
+		responses.Basic
+		LoanInfos []*loan.LoanInfo `json:"data"`
+	}
+	LoanHistory struct {
+		responses.Basic
+		Histories []*loan.LoanHistory `json:"data"`
+	}
+	InterestAccrued struct {
+		responses.Basic
+		Interests []*loan.InterestAccrued `json:"data"`
+	}
+	VIPBorrowRepay struct {
+		responses.Basic
+		BorrowRepays []*loan.VIPBorrowRepay `json:"data"`
+	}
+	VIPLoanOrders struct {
+		responses.Basic
+		Orders []*loan.VIPLoanOrder `json:"data"`
+	}
+	VIPLoanOrderDetail struct {
+		responses.Basic
+		Details []*loan.VIPLoanOrderDetail `json:"data"`
+	}
+)
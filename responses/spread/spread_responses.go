@@ -0,0 +1,49 @@
+package spread
+
+import (
+	"github.com/liuhengloveyou/okx-go/models/spread"
+	"github.com/liuhengloveyou/okx-go/responses"
+)
+
+type (
+	PlaceOrder struct {
+		responses.Basic
+		PlaceOrders []*spread.PlaceOrder `json:"data"`
+	}
+	CancelOrder struct {
+		responses.Basic
+		CancelOrders []*spread.CancelOrder `json:"data"`
+	}
+	MassCancel struct {
+		responses.Basic
+		MassCancels []*spread.MassCancel `json:"data"`
+	}
+	AmendOrder struct {
+		responses.Basic
+		AmendOrders []*spread.AmendOrder `json:"data"`
+	}
+	OrderList struct {
+		responses.Basic
+		Orders []*spread.Order `json:"data"`
+	}
+	Trades struct {
+		responses.Basic
+		Trades []*spread.Trade `json:"data"`
+	}
+	Spreads struct {
+		responses.Basic
+		Spreads []*spread.Spread `json:"data"`
+	}
+	OrderBook struct {
+		responses.Basic
+		OrderBooks []*spread.OrderBook `json:"data"`
+	}
+	Ticker struct {
+		responses.Basic
+		Tickers []*spread.Ticker `json:"data"`
+	}
+	PublicTrades struct {
+		responses.Basic
+		Trades []*spread.PublicTrade `json:"data"`
+	}
+)
@@ -50,4 +50,36 @@ type (
 		responses.Basic
 		ConvertResult []*trade.EasyConvertProcess `json:"data"`
 	}
+	AmendAlgoOrder struct {
+		responses.Basic
+		AmendAlgoOrders []*trade.AmendAlgoOrder `json:"data"`
+	}
+	OrderPrecheck struct {
+		responses.Basic
+		Prechecks []*trade.OrderPrecheck `json:"data"`
+	}
+	MassCancel struct {
+		responses.Basic
+		Results []*trade.MassCancel `json:"data"`
+	}
+	CancelAllAfter struct {
+		responses.Basic
+		CancelAllAfters []*trade.CancelAllAfter `json:"data"`
+	}
+	OneClickRepayCurrencyList struct {
+		responses.Basic
+		Currencies []*trade.OneClickRepayCurrency `json:"data"`
+	}
+	OneClickRepay struct {
+		responses.Basic
+		Repays []*trade.OneClickRepay `json:"data"`
+	}
+	RequestFillsArchive struct {
+		responses.Basic
+		Results []*trade.FillsArchiveRequest `json:"data"`
+	}
+	GetFillsArchive struct {
+		responses.Basic
+		Archives []*trade.FillsArchive `json:"data"`
+	}
 )
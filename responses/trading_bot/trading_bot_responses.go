@@ -0,0 +1,41 @@
+package trading_bot
+
+import (
+	"github.com/liuhengloveyou/okx-go/models/tradingbot"
+	"github.com/liuhengloveyou/okx-go/responses"
+)
+
+type (
+	PlaceGridOrder struct {
+		responses.Basic
+		PlaceGridOrders []*tradingbot.PlaceGridOrder `json:"data"`
+	}
+	GridOrderList struct {
+		responses.Basic
+		GridOrders []*tradingbot.GridOrder `json:"data"`
+	}
+	GridSubOrders struct {
+		responses.Basic
+		SubOrders []*tradingbot.GridSubOrder `json:"data"`
+	}
+	GridPositions struct {
+		responses.Basic
+		Positions []*tradingbot.GridPosition `json:"data"`
+	}
+	GridAIParam struct {
+		responses.Basic
+		Params []*tradingbot.GridAIParam `json:"data"`
+	}
+	MinInvestment struct {
+		responses.Basic
+		MinInvestments []*tradingbot.MinInvestment `json:"data"`
+	}
+	RecurringOrderList struct {
+		responses.Basic
+		RecurringOrders []*tradingbot.RecurringOrder `json:"data"`
+	}
+	RecurringSubOrders struct {
+		responses.Basic
+		SubOrders []*tradingbot.RecurringSubOrder `json:"data"`
+	}
+)
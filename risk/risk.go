@@ -0,0 +1,506 @@
+// Package risk is a pre-trade risk gate checked by rest.Trade, rest.Spread, rest.TradingBot and ws.Trade
+// before any order is sent or amended.
+//
+// The gate only knows what it's fed: reference prices, contract values, positions and open orders.
+// api.Client.WatchRisk feeds it from the websocket channels.
+package risk
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/liuhengloveyou/okx-go"
+	"github.com/liuhengloveyou/okx-go/models/account"
+	"github.com/liuhengloveyou/okx-go/models/trade"
+	requests "github.com/liuhengloveyou/okx-go/requests/rest/trade"
+	botRequests "github.com/liuhengloveyou/okx-go/requests/rest/tradingbot"
+)
+
+const (
+	fatFingerSamples = 20
+	fatFingerMinimum = 5
+	pendingTTL       = 5 * time.Second
+)
+
+// ErrKilled is wrapped by the rejections of the orders sent while the kill switch is on
+var ErrKilled = errors.New("okx: risk kill switch on")
+
+type (
+	// Limits of the gate, zero values disable the corresponding check
+	Limits struct {
+		// MaxOrderNotional is the maximum notional of a single order
+		MaxOrderNotional float64
+		// MaxInstNotional is the maximum notional of the open orders of an instrument, the new one included,
+		// InstNotional overrides it per instrument
+		MaxInstNotional float64
+		InstNotional    map[string]float64
+		// MaxOpenOrders is the maximum number of open orders of the account
+		MaxOpenOrders int
+		// PriceBand is the maximum relative distance of a limit order price to the reference price, 0.05 for 5%
+		PriceBand float64
+		// MaxPosition is the maximum absolute position per instrument the order could lead to, in contracts or base currency
+		MaxPosition map[string]float64
+		// FatFingerMultiple rejects the orders larger than that many times the median size of the last orders of the instrument
+		FatFingerMultiple float64
+		// RequireReference rejects the orders of the instruments without reference price instead of skipping the checks needing it
+		RequireReference bool
+	}
+	// Order is what the gate checks of an order
+	Order struct {
+		InstID     string
+		ClOrdID    string
+		Side       okx.OrderSide
+		PosSide    okx.PositionSide
+		Sz         float64
+		Px         float64 // zero for market orders
+		SzInQuote  bool    // Sz is in quote currency, such as spot market orders with tgtCcy quote_ccy
+		ReduceOnly bool
+	}
+	// Rejection is the error of a rejected order
+	Rejection struct {
+		InstID string
+		Check  string
+		Reason string
+		err    error
+	}
+
+	// Gate checks the orders against the Limits, it's safe for concurrent use
+	Gate struct {
+		mu         sync.Mutex
+		limits     Limits
+		prices     map[string]float64
+		ctVals     map[string]float64
+		positions  map[string]map[okx.PositionSide]float64
+		open       map[string]openOrder // by ordId, or clOrdId while pending
+		sizes      map[string][]float64
+		killed     bool
+		killReason string
+		onKill     []func(reason string)
+	}
+	openOrder struct {
+		instID   string
+		side     okx.OrderSide
+		sz       float64
+		notional float64
+		pending  time.Time
+	}
+)
+
+func (r *Rejection) Error() string {
+	return fmt.Sprintf("okx: risk rejected order of %s by %s check: %s", r.InstID, r.Check, r.Reason)
+}
+
+func (r *Rejection) Unwrap() error { return r.err }
+
+// NewGate returns a pointer to a fresh Gate
+func NewGate(l Limits) *Gate {
+	return &Gate{
+		limits:    l,
+		prices:    make(map[string]float64),
+		ctVals:    make(map[string]float64),
+		positions: make(map[string]map[okx.PositionSide]float64),
+		open:      make(map[string]openOrder),
+		sizes:     make(map[string][]float64),
+	}
+}
+
+// SetLimits replaces the limits of the gate
+func (g *Gate) SetLimits(l Limits) {
+	g.mu.Lock()
+	g.limits = l
+	g.mu.Unlock()
+}
+
+// SetPrice set the reference price of an instrument, such as its mark or last price
+func (g *Gate) SetPrice(instID string, px float64) {
+	g.mu.Lock()
+	g.prices[instID] = px
+	g.mu.Unlock()
+}
+
+// SetContractValue set the value of one contract of a derivative instrument in its settlement unit, sizes are multiplied by it
+func (g *Gate) SetContractValue(instID string, ctVal float64) {
+	g.mu.Lock()
+	g.ctVals[instID] = ctVal
+	g.mu.Unlock()
+}
+
+// TrackPosition updates the position of an instrument from the positions channel
+func (g *Gate) TrackPosition(p *account.Position) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.positions[p.InstID] == nil {
+		g.positions[p.InstID] = make(map[okx.PositionSide]float64)
+	}
+	pos := float64(p.Pos)
+	if p.PosSide == okx.PositionShortSide {
+		pos = -pos
+	}
+	g.positions[p.InstID][p.PosSide] = pos
+}
+
+// TrackOrder updates the open orders from the orders channel. The first report of an order replaces its pending entry,
+// found by ClOrdID, or else by instrument, side and size when the order was accepted without ClOrdID.
+func (g *Gate) TrackOrder(o *trade.Order) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if o.ClOrdID != "" {
+		delete(g.open, o.ClOrdID)
+	} else if _, ok := g.open[o.OrdID]; !ok {
+		if k, ok := g.pendingKey(o.InstID, o.Side, float64(o.Sz)); ok {
+			delete(g.open, k)
+		}
+	}
+	switch o.State {
+	case okx.OrderLive, okx.OrderPartiallyFilled:
+		px := float64(o.Px)
+		if px == 0 {
+			px = g.prices[o.InstID]
+		}
+		g.open[o.OrdID] = openOrder{instID: o.InstID, notional: (float64(o.Sz) - float64(o.AccFillSz)) * px * g.ctVal(o.InstID)}
+	default:
+		delete(g.open, o.OrdID)
+	}
+}
+
+// OnKill registers fn to be called, from the goroutine calling Kill, every time the kill switch is turned on
+func (g *Gate) OnKill(fn func(reason string)) {
+	g.mu.Lock()
+	g.onKill = append(g.onKill, fn)
+	g.mu.Unlock()
+}
+
+// Kill turns the kill switch on, all the orders are rejected until Resume is called
+func (g *Gate) Kill(reason string) {
+	g.mu.Lock()
+	g.killed, g.killReason = true, reason
+	fns := append([]func(string){}, g.onKill...)
+	g.mu.Unlock()
+	for _, fn := range fns {
+		fn(reason)
+	}
+}
+
+// Resume turns the kill switch off
+func (g *Gate) Resume() {
+	g.mu.Lock()
+	g.killed, g.killReason = false, ""
+	g.mu.Unlock()
+}
+
+// Killed reports whether the kill switch is on and why
+func (g *Gate) Killed() (bool, string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return g.killed, g.killReason
+}
+
+// NewOrder returns the Order checked for req, market orders have no price
+func NewOrder(req requests.PlaceOrder) Order {
+	o := Order{
+		InstID:     req.InstID,
+		ClOrdID:    req.ClOrdID,
+		Side:       req.Side,
+		PosSide:    req.PosSide,
+		Sz:         req.Sz,
+		Px:         req.Px,
+		SzInQuote:  req.TgtCcy == okx.QuantityQuoteCcy,
+		ReduceOnly: req.ReduceOnly,
+	}
+	if req.OrdType == okx.OrderMarket || req.OrdType == okx.OrderOptimalLimitIoc {
+		o.Px = 0
+	}
+
+	return o
+}
+
+// NewAlgoOrder returns the Order checked for req, priced at the order price of its trigger or else at the reference price
+func NewAlgoOrder(req requests.PlaceAlgoOrder) Order {
+	o := Order{
+		InstID:     req.InstID,
+		ClOrdID:    req.ClOrdID,
+		Side:       req.Side,
+		PosSide:    req.PosSide,
+		Sz:         req.Sz,
+		SzInQuote:  req.TgtCcy == okx.QuantityQuoteCcy,
+		ReduceOnly: req.ReduceOnly,
+	}
+	if req.TriggerOrder.OrdPx > 0 {
+		o.Px = req.TriggerOrder.OrdPx
+	}
+
+	return o
+}
+
+// NewGridOrder returns the Order checked for req, the whole grid as a single market order sized by its investment:
+// QuoteSz, or else BaseSz, for a spot grid and the margin times the leverage, in quote currency, for a contract grid.
+// The position limit doesn't apply to the contract grids, their size being in quote currency.
+func NewGridOrder(req botRequests.PlaceGridOrder) Order {
+	o := Order{InstID: req.InstID, ClOrdID: req.AlgoClOrdID, Side: okx.OrderBuy}
+	switch {
+	case req.AlgoOrdType == okx.AlgoOrderContractGrid:
+		lever := req.Lever
+		if lever <= 0 {
+			lever = 1
+		}
+		o.Sz, o.SzInQuote = req.ContractGrid.Sz*lever, true
+		if req.Direction == okx.GridShort {
+			o.Side = okx.OrderSell
+		}
+	case req.QuoteSz > 0:
+		o.Sz, o.SzInQuote = req.QuoteSz, true
+	default:
+		o.Sz, o.Side = req.BaseSz, okx.OrderSell
+	}
+
+	return o
+}
+
+// CheckAmend returns a *Rejection when the kill switch is on, or when the new price or size of an order
+// breaks the price band or the order notional limit. Zero newPx or newSz are left unchanged by the amendment.
+func (g *Gate) CheckAmend(instID string, newPx, newSz float64) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	l := g.limits
+
+	if g.killed {
+		return &Rejection{InstID: instID, Check: "kill switch", Reason: g.killReason, err: ErrKilled}
+	}
+	ref := g.prices[instID]
+	if err := band(l, instID, newPx, ref); err != nil {
+		return err
+	}
+	px := newPx
+	if px == 0 {
+		px = ref
+	}
+	if notional := newSz * px * g.ctVal(instID); l.MaxOrderNotional > 0 && notional > l.MaxOrderNotional {
+		return &Rejection{InstID: instID, Check: "order notional", Reason: fmt.Sprintf("notional %v above %v", notional, l.MaxOrderNotional)}
+	}
+
+	return nil
+}
+
+// CheckKill returns a *Rejection wrapping ErrKilled when the kill switch is on, it gates the requests
+// the other checks don't apply to, such as the amendments of algo orders and the recurring buy orders.
+func (g *Gate) CheckKill(instID string) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.killed {
+		return &Rejection{InstID: instID, Check: "kill switch", Reason: g.killReason, err: ErrKilled}
+	}
+
+	return nil
+}
+
+// Check returns a *Rejection when the order breaks one of the limits, it's CheckBatch with a single order.
+func (g *Gate) Check(o Order) error {
+	return g.CheckBatch([]Order{o})
+}
+
+// CheckBatch returns a *Rejection for the first of the orders breaking one of the limits, the orders before it
+// in the batch being counted as open. Nothing is recorded unless every order is accepted, each accepted order then counts
+// as open until the orders channel reports it or Release is called with it, for pendingTTL (5s) at most.
+func (g *Gate) CheckBatch(orders []Order) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	now := time.Now()
+	g.expire(now)
+
+	notionals := make([]float64, len(orders))
+	for i, o := range orders {
+		n, err := g.check(o, orders[:i], notionals[:i])
+		if err != nil {
+			return err
+		}
+		notionals[i] = n
+	}
+
+	for i, o := range orders {
+		s := append(g.sizes[o.InstID], o.Sz)
+		if len(s) > fatFingerSamples {
+			s = s[len(s)-fatFingerSamples:]
+		}
+		g.sizes[o.InstID] = s
+		key := o.ClOrdID
+		if key == "" {
+			key = fmt.Sprintf("pending-%d-%d", now.UnixNano(), i)
+		}
+		g.open[key] = openOrder{instID: o.InstID, side: o.Side, sz: o.Sz, notional: notionals[i], pending: now}
+	}
+
+	return nil
+}
+
+// Release stops counting as open the accepted orders that failed to be sent
+func (g *Gate) Release(orders ...Order) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	for _, o := range orders {
+		k := o.ClOrdID
+		if oo, ok := g.open[k]; k == "" || !ok || oo.pending.IsZero() {
+			if k, ok = g.pendingKey(o.InstID, o.Side, o.Sz); !ok {
+				continue
+			}
+		}
+		delete(g.open, k)
+	}
+}
+
+// pendingKey returns the key of the oldest pending order of instID matching side and sz
+func (g *Gate) pendingKey(instID string, side okx.OrderSide, sz float64) (string, bool) {
+	var key string
+	var at time.Time
+	for k, o := range g.open {
+		if o.pending.IsZero() || o.instID != instID || o.side != side || o.sz != sz {
+			continue
+		}
+		if key == "" || o.pending.Before(at) || (o.pending.Equal(at) && k < key) {
+			key, at = k, o.pending
+		}
+	}
+
+	return key, key != ""
+}
+
+// check returns the notional of o, or a *Rejection when it breaks one of the limits,
+// the accepted orders of its batch before it, and their notionals, being counted as open
+func (g *Gate) check(o Order, batch []Order, notionals []float64) (float64, error) {
+	l := g.limits
+	if g.killed {
+		return 0, &Rejection{InstID: o.InstID, Check: "kill switch", Reason: g.killReason, err: ErrKilled}
+	}
+	if o.Sz <= 0 {
+		return 0, &Rejection{InstID: o.InstID, Check: "size", Reason: "size isn't positive"}
+	}
+
+	ref, hasRef := g.prices[o.InstID]
+	hasRef = hasRef && ref > 0
+	if !hasRef && l.RequireReference {
+		return 0, &Rejection{InstID: o.InstID, Check: "reference price", Reason: "no reference price"}
+	}
+	if err := band(l, o.InstID, o.Px, ref); err != nil {
+		return 0, err
+	}
+
+	notional := o.Sz
+	if !o.SzInQuote {
+		px := o.Px
+		if px == 0 {
+			px = ref
+		}
+		notional = o.Sz * px * g.ctVal(o.InstID)
+	}
+	if notional == 0 && (l.MaxOrderNotional > 0 || l.MaxInstNotional > 0 || len(l.InstNotional) > 0) {
+		return 0, &Rejection{InstID: o.InstID, Check: "notional", Reason: "market order without reference price"}
+	}
+	if l.MaxOrderNotional > 0 && notional > l.MaxOrderNotional {
+		return 0, &Rejection{InstID: o.InstID, Check: "order notional", Reason: fmt.Sprintf("notional %v above %v", notional, l.MaxOrderNotional)}
+	}
+	maxInst := l.MaxInstNotional
+	if v, ok := l.InstNotional[o.InstID]; ok {
+		maxInst = v
+	}
+	if maxInst > 0 {
+		total := notional
+		for _, oo := range g.open {
+			if oo.instID == o.InstID {
+				total += oo.notional
+			}
+		}
+		for i, b := range batch {
+			if b.InstID == o.InstID {
+				total += notionals[i]
+			}
+		}
+		if total > maxInst {
+			return 0, &Rejection{InstID: o.InstID, Check: "instrument notional", Reason: fmt.Sprintf("open notional %v above %v", total, maxInst)}
+		}
+	}
+	if open := len(g.open) + len(batch); l.MaxOpenOrders > 0 && open >= l.MaxOpenOrders {
+		return 0, &Rejection{InstID: o.InstID, Check: "open orders", Reason: fmt.Sprintf("%d orders open", open)}
+	}
+
+	if max, ok := l.MaxPosition[o.InstID]; ok && !o.ReduceOnly && !o.SzInQuote {
+		var pos float64
+		for _, p := range g.positions[o.InstID] {
+			pos += p
+		}
+		for _, b := range batch {
+			if b.InstID == o.InstID && !b.ReduceOnly && !b.SzInQuote {
+				pos += signed(b)
+			}
+		}
+		next := pos + signed(o)
+		if math.Abs(next) > max && math.Abs(next) > math.Abs(pos) {
+			return 0, &Rejection{InstID: o.InstID, Check: "position", Reason: fmt.Sprintf("position %v would be above %v", next, max)}
+		}
+	}
+
+	if l.FatFingerMultiple > 0 {
+		sizes := append([]float64{}, g.sizes[o.InstID]...)
+		for _, b := range batch {
+			if b.InstID == o.InstID {
+				sizes = append(sizes, b.Sz)
+			}
+		}
+		if m, ok := median(sizes); ok && o.Sz > m*l.FatFingerMultiple {
+			return 0, &Rejection{InstID: o.InstID, Check: "fat finger", Reason: fmt.Sprintf("size %v above %v times the usual %v", o.Sz, l.FatFingerMultiple, m)}
+		}
+	}
+
+	return notional, nil
+}
+
+// expire forgets the accepted orders never reported by the orders channel
+func (g *Gate) expire(now time.Time) {
+	for k, o := range g.open {
+		if !o.pending.IsZero() && now.Sub(o.pending) > pendingTTL {
+			delete(g.open, k)
+		}
+	}
+}
+
+// signed returns the size of o, negative for a sell
+func signed(o Order) float64 {
+	if o.Side == okx.OrderSell {
+		return -o.Sz
+	}
+
+	return o.Sz
+}
+
+func (g *Gate) ctVal(instID string) float64 {
+	if v, ok := g.ctVals[instID]; ok && v > 0 {
+		return v
+	}
+
+	return 1
+}
+
+// band rejects the price px further than the price band from the reference price ref, when both are known
+func band(l Limits, instID string, px, ref float64) error {
+	if l.PriceBand <= 0 || ref <= 0 || px <= 0 {
+		return nil
+	}
+	if d := math.Abs(px/ref - 1); d > l.PriceBand {
+		return &Rejection{InstID: instID, Check: "price band", Reason: fmt.Sprintf("price %v is %.2f%% away from %v", px, d*100, ref)}
+	}
+
+	return nil
+}
+
+func median(s []float64) (float64, bool) {
+	if len(s) < fatFingerMinimum {
+		return 0, false
+	}
+	c := append([]float64{}, s...)
+	sort.Float64s(c)
+
+	return c[len(c)/2], true
+}
@@ -0,0 +1,378 @@
+package risk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/liuhengloveyou/okx-go"
+	"github.com/liuhengloveyou/okx-go/models/account"
+	"github.com/liuhengloveyou/okx-go/models/trade"
+	botRequests "github.com/liuhengloveyou/okx-go/requests/rest/tradingbot"
+)
+
+const instID = "BTC-USDT-SWAP"
+
+// newTestGate returns a gate with a reference price of 100 and a contract value of 0.1 for instID
+func newTestGate(l Limits) *Gate {
+	g := NewGate(l)
+	g.SetPrice(instID, 100)
+	g.SetContractValue(instID, 0.1)
+
+	return g
+}
+
+func rejectedBy(err error) string {
+	var r *Rejection
+	if errors.As(err, &r) {
+		return r.Check
+	}
+
+	return ""
+}
+
+func TestGateCheck(t *testing.T) {
+	tests := []struct {
+		name   string
+		limits Limits
+		setup  func(g *Gate)
+		orders []Order // checked one by one, the last one is expected to be rejected by want
+		want   string
+	}{
+		{
+			name:   "accepted",
+			limits: Limits{MaxOrderNotional: 100, PriceBand: 0.05},
+			orders: []Order{{InstID: instID, Side: okx.OrderBuy, Sz: 9, Px: 101}},
+		},
+		{
+			name:   "size",
+			orders: []Order{{InstID: instID, Side: okx.OrderBuy}},
+			want:   "size",
+		},
+		{
+			name:   "kill switch",
+			setup:  func(g *Gate) { g.Kill("drawdown") },
+			orders: []Order{{InstID: instID, Side: okx.OrderBuy, Sz: 1}},
+			want:   "kill switch",
+		},
+		{
+			name:   "reference price",
+			limits: Limits{RequireReference: true},
+			orders: []Order{{InstID: "ETH-USDT", Side: okx.OrderBuy, Sz: 1, Px: 10}},
+			want:   "reference price",
+		},
+		{
+			name:   "price band",
+			limits: Limits{PriceBand: 0.05},
+			orders: []Order{{InstID: instID, Side: okx.OrderBuy, Sz: 1, Px: 106}},
+			want:   "price band",
+		},
+		{
+			name:   "order notional at the reference price",
+			limits: Limits{MaxOrderNotional: 100},
+			orders: []Order{{InstID: instID, Side: okx.OrderBuy, Sz: 11}},
+			want:   "order notional",
+		},
+		{
+			name:   "order notional in quote currency",
+			limits: Limits{MaxOrderNotional: 100},
+			orders: []Order{{InstID: instID, Side: okx.OrderBuy, Sz: 101, SzInQuote: true}},
+			want:   "order notional",
+		},
+		{
+			name:   "market order without reference price",
+			limits: Limits{MaxOrderNotional: 100},
+			orders: []Order{{InstID: "ETH-USDT", Side: okx.OrderBuy, Sz: 1}},
+			want:   "notional",
+		},
+		{
+			name:   "instrument notional",
+			limits: Limits{MaxInstNotional: 100},
+			orders: []Order{
+				{InstID: instID, ClOrdID: "a", Side: okx.OrderBuy, Sz: 6},
+				{InstID: instID, ClOrdID: "b", Side: okx.OrderBuy, Sz: 5},
+			},
+			want: "instrument notional",
+		},
+		{
+			name:   "instrument notional override",
+			limits: Limits{MaxInstNotional: 1000, InstNotional: map[string]float64{instID: 50}},
+			orders: []Order{{InstID: instID, Side: okx.OrderBuy, Sz: 6}},
+			want:   "instrument notional",
+		},
+		{
+			name:   "open orders",
+			limits: Limits{MaxOpenOrders: 2},
+			setup: func(g *Gate) {
+				g.TrackOrder(&trade.Order{InstID: instID, OrdID: "1", State: okx.OrderLive, Sz: 1, Px: 100})
+			},
+			orders: []Order{
+				{InstID: instID, Side: okx.OrderBuy, Sz: 1},
+				{InstID: instID, Side: okx.OrderBuy, Sz: 1},
+			},
+			want: "open orders",
+		},
+		{
+			name:   "position",
+			limits: Limits{MaxPosition: map[string]float64{instID: 10}},
+			setup: func(g *Gate) {
+				g.TrackPosition(&account.Position{InstID: instID, PosSide: okx.PositionNetSide, Pos: 8})
+			},
+			orders: []Order{{InstID: instID, Side: okx.OrderBuy, Sz: 3}},
+			want:   "position",
+		},
+		{
+			name:   "position reduced",
+			limits: Limits{MaxPosition: map[string]float64{instID: 10}},
+			setup: func(g *Gate) {
+				g.TrackPosition(&account.Position{InstID: instID, PosSide: okx.PositionShortSide, Pos: 12})
+			},
+			orders: []Order{{InstID: instID, Side: okx.OrderBuy, Sz: 1}},
+		},
+		{
+			name:   "fat finger",
+			limits: Limits{FatFingerMultiple: 5},
+			orders: []Order{
+				{InstID: instID, Side: okx.OrderBuy, Sz: 1},
+				{InstID: instID, Side: okx.OrderBuy, Sz: 1},
+				{InstID: instID, Side: okx.OrderBuy, Sz: 1},
+				{InstID: instID, Side: okx.OrderBuy, Sz: 1},
+				{InstID: instID, Side: okx.OrderBuy, Sz: 1},
+				{InstID: instID, Side: okx.OrderBuy, Sz: 6},
+			},
+			want: "fat finger",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := newTestGate(tt.limits)
+			if tt.setup != nil {
+				tt.setup(g)
+			}
+			for i, o := range tt.orders {
+				err := g.Check(o)
+				want := ""
+				if i == len(tt.orders)-1 {
+					want = tt.want
+				}
+				if got := rejectedBy(err); got != want {
+					t.Fatalf("Check(order %d) rejected by %q, want %q: %v", i, got, want, err)
+				}
+			}
+		})
+	}
+}
+
+func TestGateCheckBatch(t *testing.T) {
+	tests := []struct {
+		name   string
+		limits Limits
+		batch  []Order
+		want   string
+		// next is checked after the batch, it's accepted only when the batch wasn't recorded
+		next     Order
+		wantNext string
+	}{
+		{
+			name:   "orders of the batch count as open",
+			limits: Limits{MaxInstNotional: 100},
+			batch: []Order{
+				{InstID: instID, Side: okx.OrderBuy, Sz: 6},
+				{InstID: instID, Side: okx.OrderBuy, Sz: 5},
+			},
+			want: "instrument notional",
+			next: Order{InstID: instID, Side: okx.OrderBuy, Sz: 10},
+		},
+		{
+			name:   "open orders of the batch",
+			limits: Limits{MaxOpenOrders: 2},
+			batch: []Order{
+				{InstID: instID, Side: okx.OrderBuy, Sz: 1},
+				{InstID: instID, Side: okx.OrderBuy, Sz: 1},
+				{InstID: instID, Side: okx.OrderBuy, Sz: 1},
+			},
+			want: "open orders",
+			next: Order{InstID: instID, Side: okx.OrderBuy, Sz: 1},
+		},
+		{
+			name:   "positions of the batch",
+			limits: Limits{MaxPosition: map[string]float64{instID: 10}},
+			batch: []Order{
+				{InstID: instID, Side: okx.OrderBuy, Sz: 6},
+				{InstID: instID, Side: okx.OrderBuy, Sz: 6},
+			},
+			want: "position",
+			next: Order{InstID: instID, Side: okx.OrderBuy, Sz: 10},
+		},
+		{
+			name:   "accepted batch recorded",
+			limits: Limits{MaxOpenOrders: 2},
+			batch: []Order{
+				{InstID: instID, Side: okx.OrderBuy, Sz: 1},
+				{InstID: instID, Side: okx.OrderSell, Sz: 1},
+			},
+			next:     Order{InstID: instID, Side: okx.OrderBuy, Sz: 1},
+			wantNext: "open orders",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := newTestGate(tt.limits)
+			if got := rejectedBy(g.CheckBatch(tt.batch)); got != tt.want {
+				t.Fatalf("CheckBatch() rejected by %q, want %q", got, tt.want)
+			}
+			if got := rejectedBy(g.Check(tt.next)); got != tt.wantNext {
+				t.Fatalf("Check() after the batch rejected by %q, want %q", got, tt.wantNext)
+			}
+		})
+	}
+}
+
+func TestGateTrackOrder(t *testing.T) {
+	g := newTestGate(Limits{MaxOpenOrders: 1})
+	if err := g.Check(Order{InstID: instID, ClOrdID: "a", Side: okx.OrderBuy, Sz: 1}); err != nil {
+		t.Fatalf("Check() = %v", err)
+	}
+	g.TrackOrder(&trade.Order{InstID: instID, OrdID: "1", ClOrdID: "a", State: okx.OrderLive, Sz: 1, Px: 100})
+	if got := rejectedBy(g.Check(Order{InstID: instID, Side: okx.OrderBuy, Sz: 1})); got != "open orders" {
+		t.Fatalf("Check() with a live order rejected by %q, want open orders", got)
+	}
+	g.TrackOrder(&trade.Order{InstID: instID, OrdID: "1", ClOrdID: "a", State: okx.OrderFilled, Sz: 1, AccFillSz: 1, Px: 100})
+	if err := g.Check(Order{InstID: instID, Side: okx.OrderBuy, Sz: 1}); err != nil {
+		t.Fatalf("Check() once the order is filled = %v", err)
+	}
+}
+
+func TestGateTrackOrderWithoutClOrdID(t *testing.T) {
+	g := newTestGate(Limits{MaxOpenOrders: 3})
+	buy := Order{InstID: instID, Side: okx.OrderBuy, Sz: 1}
+	if err := g.CheckBatch([]Order{buy, buy}); err != nil {
+		t.Fatalf("CheckBatch() = %v", err)
+	}
+	// each report replaces one pending order, a second report of the same order none
+	g.TrackOrder(&trade.Order{InstID: instID, OrdID: "1", Side: okx.OrderBuy, State: okx.OrderLive, Sz: 1, Px: 100})
+	g.TrackOrder(&trade.Order{InstID: instID, OrdID: "1", Side: okx.OrderBuy, State: okx.OrderPartiallyFilled, Sz: 1, AccFillSz: 0.5, Px: 100})
+	g.TrackOrder(&trade.Order{InstID: instID, OrdID: "2", Side: okx.OrderBuy, State: okx.OrderLive, Sz: 1, Px: 100})
+	if got := len(g.open); got != 2 {
+		t.Fatalf("%d open orders, want 2", got)
+	}
+	if err := g.Check(buy); err != nil {
+		t.Fatalf("Check() with 2 open orders = %v", err)
+	}
+}
+
+func TestGateRelease(t *testing.T) {
+	g := newTestGate(Limits{MaxOpenOrders: 2})
+	orders := []Order{{InstID: instID, ClOrdID: "a", Side: okx.OrderBuy, Sz: 1}, {InstID: instID, Side: okx.OrderSell, Sz: 2}}
+	if err := g.CheckBatch(orders); err != nil {
+		t.Fatalf("CheckBatch() = %v", err)
+	}
+	if got := rejectedBy(g.Check(Order{InstID: instID, Side: okx.OrderBuy, Sz: 1})); got != "open orders" {
+		t.Fatalf("Check() with 2 pending orders rejected by %q, want open orders", got)
+	}
+	g.Release(orders...)
+	if got := len(g.open); got != 0 {
+		t.Fatalf("%d open orders after Release, want 0", got)
+	}
+
+	// a reported order isn't pending anymore
+	if err := g.Check(orders[0]); err != nil {
+		t.Fatalf("Check() = %v", err)
+	}
+	g.TrackOrder(&trade.Order{InstID: instID, OrdID: "1", ClOrdID: "a", Side: okx.OrderBuy, State: okx.OrderLive, Sz: 1, Px: 100})
+	g.Release(orders[0])
+	if got := len(g.open); got != 1 {
+		t.Errorf("%d open orders after releasing a live order, want 1", got)
+	}
+}
+
+func TestGateKill(t *testing.T) {
+	g := newTestGate(Limits{})
+	var reasons []string
+	g.OnKill(func(reason string) { reasons = append(reasons, reason) })
+	g.Kill("drawdown")
+
+	checks := map[string]error{
+		"Check":      g.Check(Order{InstID: instID, Side: okx.OrderBuy, Sz: 1}),
+		"CheckBatch": g.CheckBatch([]Order{{InstID: instID, Side: okx.OrderBuy, Sz: 1}}),
+		"CheckAmend": g.CheckAmend(instID, 0, 1),
+		"CheckKill":  g.CheckKill(instID),
+	}
+	for name, err := range checks {
+		if !errors.Is(err, ErrKilled) {
+			t.Errorf("%s() = %v, want ErrKilled", name, err)
+		}
+	}
+	if killed, reason := g.Killed(); !killed || reason != "drawdown" || len(reasons) != 1 || reasons[0] != "drawdown" {
+		t.Errorf("Killed() = %v, %q and OnKill got %v, want drawdown", killed, reason, reasons)
+	}
+
+	g.Resume()
+	if err := g.CheckKill(instID); err != nil {
+		t.Errorf("CheckKill() after Resume = %v", err)
+	}
+	if err := g.Check(Order{InstID: instID, Side: okx.OrderBuy, Sz: 1}); err != nil {
+		t.Errorf("Check() after Resume = %v", err)
+	}
+}
+
+func TestGateCheckAmend(t *testing.T) {
+	tests := []struct {
+		name  string
+		newPx float64
+		newSz float64
+		want  string
+	}{
+		{name: "accepted", newPx: 102, newSz: 9},
+		{name: "size only", newSz: 10},
+		{name: "price band", newPx: 110, want: "price band"},
+		{name: "order notional", newPx: 101, newSz: 10, want: "order notional"},
+		{name: "order notional at the reference price", newSz: 11, want: "order notional"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := newTestGate(Limits{MaxOrderNotional: 100, PriceBand: 0.05})
+			if got := rejectedBy(g.CheckAmend(instID, tt.newPx, tt.newSz)); got != tt.want {
+				t.Errorf("CheckAmend() rejected by %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewGridOrder(t *testing.T) {
+	tests := []struct {
+		name string
+		req  botRequests.PlaceGridOrder
+		want Order
+	}{
+		{
+			name: "spot grid in quote currency",
+			req:  botRequests.PlaceGridOrder{InstID: "BTC-USDT", AlgoOrdType: okx.AlgoOrderGrid, SpotGrid: botRequests.SpotGrid{QuoteSz: 100}},
+			want: Order{InstID: "BTC-USDT", Side: okx.OrderBuy, Sz: 100, SzInQuote: true},
+		},
+		{
+			name: "spot grid in base currency",
+			req:  botRequests.PlaceGridOrder{InstID: "BTC-USDT", AlgoOrdType: okx.AlgoOrderGrid, SpotGrid: botRequests.SpotGrid{BaseSz: 0.1}},
+			want: Order{InstID: "BTC-USDT", Side: okx.OrderSell, Sz: 0.1},
+		},
+		{
+			name: "short contract grid",
+			req: botRequests.PlaceGridOrder{
+				InstID: instID, AlgoOrdType: okx.AlgoOrderContractGrid, AlgoClOrdID: "g1",
+				ContractGrid: botRequests.ContractGrid{Sz: 100, Lever: 5, Direction: okx.GridShort},
+			},
+			want: Order{InstID: instID, ClOrdID: "g1", Side: okx.OrderSell, Sz: 500, SzInQuote: true},
+		},
+		{
+			name: "contract grid without leverage",
+			req:  botRequests.PlaceGridOrder{InstID: instID, AlgoOrdType: okx.AlgoOrderContractGrid, ContractGrid: botRequests.ContractGrid{Sz: 100, Direction: okx.GridLong}},
+			want: Order{InstID: instID, Side: okx.OrderBuy, Sz: 100, SzInQuote: true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewGridOrder(tt.req); got != tt.want {
+				t.Errorf("NewGridOrder() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}